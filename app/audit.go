@@ -0,0 +1,136 @@
+package app
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v3"
+)
+
+const (
+	defaultAuditLimit = 500
+	maxAuditLimit     = 10000
+)
+
+// auditStatuses labels the statusid values the scraper writes to baseballreference.audit
+var auditStatuses = map[int]string{
+	0: "success",
+	1: "insert_error",
+	2: "request_timeout",
+	3: "table_timeout",
+	4: "parse_error",
+}
+
+// parseAuditStatus accepts a statusid or its label
+func parseAuditStatus(value string) (int, error) {
+	if id, err := strconv.Atoi(value); err == nil {
+		if _, ok := auditStatuses[id]; ok {
+			return id, nil
+		}
+	}
+	for id, label := range auditStatuses {
+		if strings.EqualFold(label, value) {
+			return id, nil
+		}
+	}
+	return 0, errors.Errorf("unknown audit status: %s", value)
+}
+
+// auditFilter selects audit rows by team, table, status and createddate range
+type auditFilter struct {
+	team   string
+	table  string
+	status null.Int
+	snap   snapshot
+	limit  int
+}
+
+// parseAuditFilter reads the team, table, status, from, to and limit parameters
+func parseAuditFilter(r *http.Request) (f auditFilter, err error) {
+	query := r.URL.Query()
+	f.team = query.Get("team")
+	if f.table = query.Get("table"); f.table != "" && !isSnapshotTable(f.table) {
+		err = errors.Errorf("unknown table: %s", f.table)
+		return
+	}
+	if status := query.Get("status"); status != "" {
+		id, err := parseAuditStatus(status)
+		if err != nil {
+			return f, err
+		}
+		f.status = null.IntFrom(int64(id))
+	}
+	if f.snap, err = parseRange(r); err != nil {
+		return
+	}
+	f.limit = defaultAuditLimit
+	if param := query.Get("limit"); param != "" {
+		if f.limit, err = strconv.Atoi(param); err != nil || f.limit < 1 || f.limit > maxAuditLimit {
+			err = errors.Errorf("limit must be between 1 and %d", maxAuditLimit)
+			return
+		}
+	}
+	return
+}
+
+// query builds a select of the audit rows matching f, newest first
+func (f auditFilter) query(d dialect) (string, []interface{}) {
+	var (
+		where []string
+		args  []interface{}
+	)
+	if f.team != "" {
+		args = append(args, f.team)
+		where = append(where, "t.teamabbrev = ?")
+	}
+	if f.table != "" {
+		args = append(args, f.table)
+		where = append(where, "a.tablename = ?")
+	}
+	if f.status.Valid {
+		args = append(args, f.status.Int64)
+		where = append(where, "a.statusid = ?")
+	}
+	where, args = f.snap.conditions("a.createddate", where, args)
+	args = append(args, f.limit)
+	var whereClause string
+	if len(where) > 0 {
+		whereClause = "WHERE	" + strings.Join(where, "\n\t\tAND\t")
+	}
+	return fmt.Sprintf(
+		`SELECT	a.id, a.statusid, t.teamabbrev, a.tablename, a.error, a.createddate
+		FROM	%s a
+				LEFT JOIN %s t ON t.id = a.teamid
+		%s
+		ORDER BY a.createddate DESC, a.id DESC
+		%s`,
+		d.table("audit"), d.table("team"), whereClause, d.fetch,
+	), args
+}
+
+// freshnessQuery builds a select of the latest successful and failed scrape per table per team; the conditional
+// aggregates are written as CASE expressions since only Postgres and SQLite support FILTER
+func freshnessQuery(d dialect, team string) (string, []interface{}) {
+	var (
+		args        []interface{}
+		whereClause = "WHERE	a.tablename IS NOT NULL"
+	)
+	if team != "" {
+		args = append(args, team)
+		whereClause += "\n\t\tAND\tt.teamabbrev = ?"
+	}
+	return fmt.Sprintf(
+		`SELECT	t.teamabbrev, a.tablename,
+				MAX(CASE WHEN a.statusid = 0 THEN a.createddate END) AS lastsuccess,
+				MAX(CASE WHEN a.statusid <> 0 THEN a.createddate END) AS lastfailure
+		FROM	%s a
+				INNER JOIN %s t ON t.id = a.teamid
+		%s
+		GROUP BY t.teamabbrev, a.tablename
+		ORDER BY t.teamabbrev, a.tablename`,
+		d.table("audit"), d.table("team"), whereClause,
+	), args
+}
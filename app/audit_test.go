@@ -0,0 +1,128 @@
+package app
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestParseAuditStatus(t *testing.T) {
+	tests := []struct {
+		value string
+		want  int
+		ok    bool
+	}{
+		{"0", 0, true},
+		{"success", 0, true},
+		{"TABLE_TIMEOUT", 3, true},
+		{"parse_error", 4, true},
+		{"4", 4, true},
+		{"5", 0, false},
+		{"-1", 0, false},
+		{"timeout", 0, false},
+		{"", 0, false},
+	}
+	for _, tt := range tests {
+		got, err := parseAuditStatus(tt.value)
+		if (err == nil) != tt.ok || got != tt.want {
+			t.Errorf("parseAuditStatus(%q) = %d %v, want %d ok %v", tt.value, got, err, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestGetAudit(t *testing.T) {
+	ts := newTestServer(t, fixtureRepository(t))
+	tests := []struct {
+		query  string
+		ids    string // ids of the rows returned, newest first
+		status int
+		code   string
+	}{
+		{"", "[3 2 1]", http.StatusOK, ""},
+		{"status=success", "[2 1]", http.StatusOK, ""},
+		{"status=3", "[3]", http.StatusOK, ""},
+		{"status=Table_Timeout", "[3]", http.StatusOK, ""},
+		{"status=request_timeout", "[]", http.StatusOK, ""},
+		{"status=9", "", http.StatusBadRequest, codeInvalidParameter},
+		{"team=bos", "[3 1]", http.StatusOK, ""},
+		{"team=XYZ", "", http.StatusNotFound, codeUnknownTeam},
+		{"table=pitching", "[3]", http.StatusOK, ""},
+		{"table=fielding", "", http.StatusBadRequest, codeInvalidParameter},
+		{"from=2020-08-02", "[3 2]", http.StatusOK, ""},
+		{"to=2020-08-01", "[1]", http.StatusOK, ""},
+		{"from=2020-08-08T06:00:00Z&to=2020-08-08T06:00:00Z", "[3 2]", http.StatusOK, ""},
+		{"from=2020-08-09&to=2020-08-01", "", http.StatusBadRequest, codeInvalidParameter},
+		{"from=last+week", "", http.StatusBadRequest, codeInvalidParameter},
+		{"to=2020-13-01", "", http.StatusBadRequest, codeInvalidParameter},
+		{"limit=1", "[3]", http.StatusOK, ""},
+		{fmt.Sprintf("limit=%d", maxAuditLimit), "[3 2 1]", http.StatusOK, ""},
+		{"limit=0", "", http.StatusBadRequest, codeInvalidParameter},
+		{fmt.Sprintf("limit=%d", maxAuditLimit+1), "", http.StatusBadRequest, codeInvalidParameter},
+		{"limit=ten", "", http.StatusBadRequest, codeInvalidParameter},
+	}
+	for _, tt := range tests {
+		path := "/api/v1/admin/audit?" + tt.query
+		if tt.status != http.StatusOK {
+			if p := ts.problem(path, tt.status); p.Code != tt.code {
+				t.Errorf("GET %s: code %s, want %s", path, p.Code, tt.code)
+			}
+			continue
+		}
+		var audits []Audit
+		ts.get(path, http.StatusOK, &audits)
+		ids := []int{}
+		for _, a := range audits {
+			ids = append(ids, a.ID)
+			if a.Status != auditStatuses[a.Statusid] {
+				t.Errorf("GET %s: audit %d labelled %q, want %q", path, a.ID, a.Status, auditStatuses[a.Statusid])
+			}
+		}
+		if got := fmt.Sprint(ids); got != tt.ids {
+			t.Errorf("GET %s: audits %s, want %s", path, got, tt.ids)
+		}
+	}
+}
+
+func TestGetFreshness(t *testing.T) {
+	ts := newTestServer(t, fixtureRepository(t))
+	tests := []struct {
+		path   string
+		want   string // teamabbrev/tablename with the dates of the last success and failure
+		status int
+	}{
+		{"/api/v1/mlb/freshness", "[BOS/batting 2020-08-01 - BOS/pitching - 2020-08-08 NYY/batting 2020-08-08 -]", http.StatusOK},
+		{"/api/v1/mlb/freshness/nyy", "[NYY/batting 2020-08-08 -]", http.StatusOK},
+		{"/api/v1/mlb/freshness/NYY?format=csv", "", http.StatusOK},
+		{"/api/v1/mlb/freshness/XYZ", "", http.StatusNotFound},
+		{"/api/v1/mlb/freshness?format=xml", "", http.StatusBadRequest},
+	}
+	date := func(rows []Freshness, i int, success bool) string {
+		t := rows[i].Lastfailure
+		if success {
+			t = rows[i].Lastsuccess
+		}
+		if !t.Valid {
+			return "-"
+		}
+		return t.Time.Format(dateLayout)
+	}
+	for _, tt := range tests {
+		if tt.status != http.StatusOK {
+			ts.problem(tt.path, tt.status)
+			continue
+		}
+		if tt.want == "" {
+			ts.get(tt.path, http.StatusOK, nil)
+			continue
+		}
+		var rows []Freshness
+		ts.get(tt.path, http.StatusOK, &rows)
+		var got []string
+		for i, row := range rows {
+			got = append(got, fmt.Sprintf("%s/%s %s %s", row.Teamabbrev, row.Tablename, date(rows, i, true), date(rows, i, false)))
+		}
+		if fmt.Sprint(got) != tt.want {
+			t.Errorf("GET %s: %v, want %s", tt.path, got, tt.want)
+		}
+	}
+}
@@ -1,7 +1,7 @@
 package app
 
 import (
-	"encoding/json"
+	"context"
 	"log"
 	"net/http"
 	"os"
@@ -9,7 +9,6 @@ import (
 
 	"github.com/dgrijalva/jwt-go"
 	"github.com/pkg/errors"
-	"gopkg.in/guregu/null.v3"
 )
 
 // JwtToken represents an authorization token
@@ -43,13 +42,15 @@ func (s *Server) Authenticate(next http.Handler) http.Handler {
 		case nil: // if err is nil do nothing
 		case http.ErrNoCookie: // if no cookie is present check for the token header
 			bearerToken, err = checkBearerTokenHeader(r)
-			if ok := checkWriteError(err, http.StatusUnauthorized, w); ok {
+			if err != nil {
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				writeProblem(w, r, newAPIError(http.StatusUnauthorized, codeMissingToken, err))
 				return
 			}
 		default:
-			if ok := checkWriteError(errors.Wrap(err, "no authorization token found"), http.StatusUnauthorized, w); ok {
-				return
-			}
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			writeProblem(w, r, newAPIError(http.StatusUnauthorized, codeMissingToken, errors.Wrap(err, "no authorization token found")))
+			return
 		}
 
 		ok := authenticateToken(bearerToken) // if token is found then attempt to authenticate
@@ -57,21 +58,21 @@ func (s *Server) Authenticate(next http.Handler) http.Handler {
 			next.ServeHTTP(w, r)
 			return
 		}
-		json.NewEncoder(w).Encode(Exception{Status: http.StatusUnauthorized, Message: "invalid authorization token"})
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		writeProblem(w, r, newAPIError(http.StatusUnauthorized, codeInvalidToken, errors.New("invalid authorization token")))
 		return
 	})
 }
 
-func (s *Server) validateCredentials(user User) bool {
-	// execute user_role(user, pass) func in database and scan result into null.String; null == ""
-	var role null.String
-	err := s.Dbc.Db.Get(&role, "SELECT basic_auth.user_role($1, $2)", user.Username, user.Password)
+func (s *Server) validateCredentials(ctx context.Context, user User) bool {
+	// look up the user's role; "" means the user credentials do not exist in the repository
+	role, err := s.Repo.UserRole(ctx, user.Username, user.Password)
 	if err != nil {
 		log.Println(errors.Wrap(err, "error querying users table"))
 		return false
 	}
-	// if value is "" then user credentials do not exist in database; reject
-	if role.NullString.String != "" {
+	// if value is "" then user credentials do not exist; reject
+	if role != "" {
 		return true
 	}
 	return false
@@ -0,0 +1,184 @@
+package app
+
+import (
+	"bytes"
+	"container/list"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// defaults used when the cache size and ttl are not configured
+const (
+	DefaultCacheSize = 512
+	DefaultCacheTTL  = 10 * time.Minute
+)
+
+// maxCacheEntryBytes bounds a single cached response; larger responses are served but not stored
+const maxCacheEntryBytes = 4 << 20
+
+// cachedHeaders are the response headers stored with a cached body
+var cachedHeaders = []string{"Content-Type", "Link"}
+
+// Cache is an LRU of encoded stat responses keyed by table, team, format and query parameters. An entry is only
+// served while the newest change behind it is unchanged and it is younger than the ttl, so a new scraper snapshot,
+// or a load run rewriting one, invalidates it on the next request
+type Cache struct {
+	mu      sync.Mutex
+	size    int
+	ttl     time.Duration
+	entries map[string]*list.Element
+	lru     *list.List
+	stats   CacheStats
+}
+
+// CacheStats reports cache configuration and hit/miss counters
+type CacheStats struct {
+	Size      int    `json:"size"`
+	TTL       string `json:"ttl"`
+	Entries   int    `json:"entries"`
+	Hits      uint64 `json:"hits"`
+	Misses    uint64 `json:"misses"`
+	Stale     uint64 `json:"stale"` // misses on an entry invalidated by a newer snapshot or the ttl
+	Evictions uint64 `json:"evictions"`
+}
+
+type cacheEntry struct {
+	key     string
+	version time.Time // newest change behind the response, as lastModified reports it
+	stored  time.Time
+	header  http.Header
+	body    []byte
+}
+
+// NewCache creates a cache holding at most size responses for up to ttl; a size below 1 disables caching
+func NewCache(size int, ttl time.Duration) *Cache {
+	if size < 1 {
+		return nil
+	}
+	return &Cache{size: size, ttl: ttl, entries: map[string]*list.Element{}, lru: list.New()}
+}
+
+// get returns the entry for key when it was stored from the snapshot identified by version and has not expired
+func (c *Cache) get(key string, version time.Time) (*cacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.entries[key]
+	if !ok {
+		c.stats.Misses++
+		return nil, false
+	}
+	entry := el.Value.(*cacheEntry)
+	if !entry.version.Equal(version) || (c.ttl > 0 && time.Since(entry.stored) > c.ttl) {
+		c.lru.Remove(el)
+		delete(c.entries, key)
+		c.stats.Misses++
+		c.stats.Stale++
+		return nil, false
+	}
+	c.lru.MoveToFront(el)
+	c.stats.Hits++
+	return entry, true
+}
+
+// put stores an entry, evicting the least recently used entries beyond the size
+func (c *Cache) put(entry *cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[entry.key]; ok {
+		c.lru.Remove(el)
+	}
+	c.entries[entry.key] = c.lru.PushFront(entry)
+	for c.lru.Len() > c.size {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+		c.stats.Evictions++
+	}
+}
+
+// Stats returns a snapshot of the cache counters
+func (c *Cache) Stats() CacheStats {
+	if c == nil {
+		return CacheStats{}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stats := c.stats
+	stats.Size, stats.TTL, stats.Entries = c.size, c.ttl.String(), c.lru.Len()
+	return stats
+}
+
+// cacheRecorder passes a response through to the client while keeping a copy of the body for the cache
+type cacheRecorder struct {
+	http.ResponseWriter
+	status   int
+	body     bytes.Buffer
+	overflow bool
+}
+
+func (rec *cacheRecorder) WriteHeader(status int) {
+	rec.status = status
+	rec.ResponseWriter.WriteHeader(status)
+}
+
+func (rec *cacheRecorder) Write(p []byte) (int, error) {
+	if rec.status == 0 {
+		rec.status = http.StatusOK
+	}
+	if !rec.overflow {
+		if rec.body.Len()+len(p) > maxCacheEntryBytes {
+			rec.overflow = true
+			rec.body = bytes.Buffer{}
+		} else {
+			rec.body.Write(p)
+		}
+	}
+	return rec.ResponseWriter.Write(p)
+}
+
+// Flush keeps streamed formats flowing to the client
+func (rec *cacheRecorder) Flush() {
+	if f, ok := rec.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// cacheKey identifies a response by table, team, format and the request's sorted query parameters
+func cacheKey(r *http.Request, table, team, format string) string {
+	return table + "|" + team + "|" + format + "|" + r.URL.Path + "?" + r.URL.Query().Encode()
+}
+
+// serveCached writes the cached response for key when one was stored from the snapshot identified by version;
+// otherwise it renders the response and stores it when render succeeds with a 200
+func (s *Server) serveCached(w http.ResponseWriter, key string, version time.Time, render func(w http.ResponseWriter) error) {
+	if s.Cache == nil {
+		render(w) // errors are logged by the renderer
+		return
+	}
+	if entry, ok := s.Cache.get(key, version); ok {
+		for name, values := range entry.header {
+			w.Header()[name] = values
+		}
+		w.Header().Set("X-Cache", "HIT")
+		if _, err := w.Write(entry.body); err != nil {
+			log.Println(errors.Wrap(err, "error writing cached response"))
+		}
+		return
+	}
+	w.Header().Set("X-Cache", "MISS")
+	rec := &cacheRecorder{ResponseWriter: w}
+	if err := render(rec); err != nil || rec.status != http.StatusOK || rec.overflow {
+		return
+	}
+	header := http.Header{}
+	for _, name := range cachedHeaders {
+		if values := w.Header().Values(name); len(values) > 0 {
+			header[name] = values
+		}
+	}
+	s.Cache.put(&cacheEntry{key: key, version: version, stored: time.Now(), header: header, body: rec.body.Bytes()})
+}
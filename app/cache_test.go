@@ -0,0 +1,94 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi"
+	"gopkg.in/guregu/null.v3"
+)
+
+func TestCacheGet(t *testing.T) {
+	v1 := time.Date(2020, 8, 1, 6, 0, 0, 0, time.UTC)
+	v2 := v1.Add(7 * 24 * time.Hour)
+	c := NewCache(2, time.Hour)
+	if _, ok := c.get("a", v1); ok {
+		t.Fatal("get from an empty cache: want a miss")
+	}
+	c.put(&cacheEntry{key: "a", version: v1, stored: time.Now()})
+	if _, ok := c.get("a", v1); !ok {
+		t.Error("get of the stored version: want a hit")
+	}
+	if _, ok := c.get("a", v2); ok {
+		t.Error("get after a newer createddate: want the entry invalidated")
+	}
+	if _, ok := c.get("a", v1); ok {
+		t.Error("get of the invalidated entry: want it removed")
+	}
+	c.put(&cacheEntry{key: "b", version: v1, stored: time.Now().Add(-2 * time.Hour)})
+	if _, ok := c.get("b", v1); ok {
+		t.Error("get of an entry older than the ttl: want it expired")
+	}
+	want := CacheStats{Size: 2, TTL: "1h0m0s", Hits: 1, Misses: 4, Stale: 2}
+	if got := c.Stats(); got != want {
+		t.Errorf("stats %+v, want %+v", got, want)
+	}
+
+	for _, key := range []string{"a", "b"} {
+		c.put(&cacheEntry{key: key, version: v1, stored: time.Now()})
+	}
+	c.get("a", v1) // b is now the least recently used
+	c.put(&cacheEntry{key: "c", version: v1, stored: time.Now()})
+	if _, ok := c.get("b", v1); ok {
+		t.Error("get of the least recently used entry: want it evicted")
+	}
+	if _, ok := c.get("a", v1); !ok {
+		t.Error("get of a recently used entry: want it kept")
+	}
+	if stats := c.Stats(); stats.Entries != 2 || stats.Evictions != 1 {
+		t.Errorf("stats %+v, want 2 entries after 1 eviction", stats)
+	}
+
+	if NewCache(0, time.Hour) != nil {
+		t.Error("NewCache(0): want caching disabled")
+	}
+}
+
+// versionRepository reports a settable newest change in place of the repository's own
+type versionRepository struct {
+	StatsRepository
+	modified time.Time
+}
+
+func (v *versionRepository) LastModified(ctx context.Context, table, team string, snap snapshot) (null.Time, error) {
+	return null.TimeFrom(v.modified), nil
+}
+
+func TestCachedStats(t *testing.T) {
+	repo := &versionRepository{StatsRepository: fixtureRepository(t), modified: time.Date(2020, 8, 8, 6, 0, 0, 0, time.UTC)}
+	ts := serveTestServer(t, &Server{Repo: repo, Router: chi.NewRouter(), Cache: NewCache(8, time.Hour)})
+	cache := func(path string) string {
+		t.Helper()
+		return ts.get(path, http.StatusOK, nil).Header.Get("X-Cache")
+	}
+	if got := cache("/api/v1/mlb/batting/NYY"); got != "MISS" {
+		t.Errorf("first request: X-Cache %s, want MISS", got)
+	}
+	if got := cache("/api/v1/mlb/batting/NYY"); got != "HIT" {
+		t.Errorf("repeated request: X-Cache %s, want HIT", got)
+	}
+	if got := cache("/api/v1/mlb/batting/NYY?format=csv"); got != "MISS" {
+		t.Errorf("request in another format: X-Cache %s, want MISS", got)
+	}
+	repo.modified = repo.modified.Add(time.Hour)
+	if got := cache("/api/v1/mlb/batting/NYY"); got != "MISS" {
+		t.Errorf("request after a newer createddate: X-Cache %s, want MISS", got)
+	}
+	var stats CacheStats
+	ts.get("/api/v1/admin/cache", http.StatusOK, &stats)
+	if stats.Hits != 1 || stats.Misses != 3 || stats.Stale != 1 || stats.Entries != 2 {
+		t.Errorf("stats %+v, want 1 hit and 3 misses, 1 of them stale", stats)
+	}
+}
@@ -0,0 +1,219 @@
+package app
+
+import (
+	"compress/gzip"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+	"github.com/pkg/errors"
+)
+
+// minCompressSize is the smallest response worth compressing; smaller bodies are sent as they are
+const minCompressSize = 1024
+
+// compressibleTypes are the media types the compression middleware encodes; exports in parquet and arrow are
+// already compact and are sent as they are
+var compressibleTypes = []string{
+	"application/json",
+	"application/x-ndjson",
+	"application/problem+json",
+	"text/csv",
+	"text/tab-separated-values",
+	"text/html",
+}
+
+// compressEncodings lists the supported content codings in order of preference
+var compressEncodings = []string{"br", "gzip"}
+
+// encoder is the part of gzip.Writer and brotli.Writer the middleware uses
+type encoder interface {
+	io.WriteCloser
+	Flush() error
+	Reset(w io.Writer)
+}
+
+var encoderPools = map[string]*sync.Pool{
+	"br":   {New: func() interface{} { return brotli.NewWriterLevel(nil, 4) }},
+	"gzip": {New: func() interface{} { return gzip.NewWriter(nil) }},
+}
+
+// negotiateEncoding picks the preferred content coding accepted by an Accept-Encoding header, or "" for identity
+func negotiateEncoding(header string) string {
+	accepted := map[string]float64{}
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(part, ";")
+		coding := strings.ToLower(strings.TrimSpace(fields[0]))
+		q := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if v, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					q = v
+				}
+			}
+		}
+		if coding != "" {
+			accepted[coding] = q
+		}
+	}
+	best, bestQ := "", 0.0
+	for _, coding := range compressEncodings {
+		q, ok := accepted[coding]
+		if !ok {
+			q, ok = accepted["*"]
+		}
+		if ok && q > bestQ {
+			best, bestQ = coding, q
+		}
+	}
+	return best
+}
+
+// compressResponses encodes responses of the given media types with gzip or brotli, as negotiated by Accept-Encoding,
+// once they reach minSize bytes. Only the first minSize bytes are held back; a Flush from a streaming writer commits
+// to compressing and flushes the encoder so NDJSON and CSV rows keep reaching the client as they are written
+func compressResponses(minSize int, types ...string) func(http.Handler) http.Handler {
+	allowed := map[string]bool{}
+	for _, t := range types {
+		allowed[t] = true
+	}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Add("Vary", "Accept-Encoding")
+			encoding := negotiateEncoding(r.Header.Get("Accept-Encoding"))
+			if encoding == "" || r.Method == http.MethodHead {
+				next.ServeHTTP(w, r)
+				return
+			}
+			cw := &compressWriter{ResponseWriter: w, encoding: encoding, minSize: minSize, allowed: allowed}
+			// closed even when next panics, so the pooled encoder is returned before the panic reaches Recoverer
+			defer func() {
+				if err := cw.close(); err != nil {
+					log.Println(errors.Wrap(err, "error closing compressed response"))
+				}
+			}()
+			next.ServeHTTP(cw, r)
+		})
+	}
+}
+
+// compressWriter holds back the start of a response until it knows whether to compress it
+type compressWriter struct {
+	http.ResponseWriter
+	encoding string
+	minSize  int
+	allowed  map[string]bool
+	status   int
+	buf      []byte
+	started  bool
+	enc      encoder
+}
+
+// compressible reports whether the response status, type and existing coding allow it to be compressed
+func (cw *compressWriter) compressible() bool {
+	if cw.status == http.StatusNoContent || cw.status == http.StatusNotModified || (cw.status != 0 && cw.status < 200) {
+		return false
+	}
+	if cw.Header().Get("Content-Encoding") != "" {
+		return false
+	}
+	mediaType, _, err := mime.ParseMediaType(cw.Header().Get("Content-Type"))
+	return err == nil && cw.allowed[mediaType]
+}
+
+// start writes the header, compressed or not, followed by any held back bytes
+func (cw *compressWriter) start(compressed bool) error {
+	cw.started = true
+	if compressed {
+		cw.Header().Set("Content-Encoding", cw.encoding)
+		cw.Header().Del("Content-Length")
+		cw.enc = encoderPools[cw.encoding].Get().(encoder)
+		cw.enc.Reset(cw.ResponseWriter)
+	}
+	if cw.status != 0 {
+		cw.ResponseWriter.WriteHeader(cw.status)
+	}
+	if len(cw.buf) == 0 {
+		return nil
+	}
+	buf := cw.buf
+	cw.buf = nil
+	if cw.enc != nil {
+		_, err := cw.enc.Write(buf)
+		return err
+	}
+	_, err := cw.ResponseWriter.Write(buf)
+	return err
+}
+
+func (cw *compressWriter) WriteHeader(status int) {
+	if cw.started {
+		cw.ResponseWriter.WriteHeader(status)
+		return
+	}
+	cw.status = status
+	if !cw.compressible() {
+		cw.start(false)
+	}
+}
+
+func (cw *compressWriter) Write(p []byte) (int, error) {
+	if !cw.started {
+		if !cw.compressible() {
+			if err := cw.start(false); err != nil {
+				return 0, err
+			}
+			return cw.ResponseWriter.Write(p)
+		}
+		cw.buf = append(cw.buf, p...)
+		if len(cw.buf) < cw.minSize {
+			return len(p), nil
+		}
+		return len(p), cw.start(true)
+	}
+	if cw.enc != nil {
+		return cw.enc.Write(p)
+	}
+	return cw.ResponseWriter.Write(p)
+}
+
+// Flush sends what has been written so far; a streamed response of a compressible type is compressed even when
+// it has not reached the minimum size, since more is expected
+func (cw *compressWriter) Flush() {
+	if !cw.started {
+		if err := cw.start(cw.compressible()); err != nil {
+			log.Println(errors.Wrap(err, "error flushing compressed response"))
+			return
+		}
+	}
+	if cw.enc != nil {
+		if err := cw.enc.Flush(); err != nil {
+			log.Println(errors.Wrap(err, "error flushing compressed response"))
+			return
+		}
+	}
+	if f, ok := cw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// close sends a response that stayed below the minimum size as it is, or finishes the compressed stream
+func (cw *compressWriter) close() error {
+	if !cw.started {
+		return cw.start(false)
+	}
+	if cw.enc == nil {
+		return nil
+	}
+	err := cw.enc.Close()
+	cw.enc.Reset(nil)
+	encoderPools[cw.encoding].Put(cw.enc)
+	cw.enc = nil
+	return err
+}
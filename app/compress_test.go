@@ -0,0 +1,188 @@
+package app
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNegotiateEncoding(t *testing.T) {
+	tests := []struct {
+		header string
+		want   string
+	}{
+		{"", ""},
+		{"gzip", "gzip"},
+		{"gzip, deflate, br", "br"},
+		{"gzip;q=0.8, br;q=0.5", "gzip"},
+		{"br;q=0, gzip", "gzip"},
+		{"gzip;q=0", ""},
+		{"BR;Q=1", "br"},
+		{"*", "br"},
+		{"*;q=0", ""},
+		{"*, br;q=0", "gzip"},
+		{"identity, deflate", ""},
+	}
+	for _, tt := range tests {
+		if got := negotiateEncoding(tt.header); got != tt.want {
+			t.Errorf("negotiateEncoding(%q) = %q, want %q", tt.header, got, tt.want)
+		}
+	}
+}
+
+// compressed serves handler through the compression middleware with a 16 byte minimum and returns the response
+func compressed(handler http.HandlerFunc, acceptEncoding string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Accept-Encoding", acceptEncoding)
+	w := httptest.NewRecorder()
+	compressResponses(16, compressibleTypes...)(handler).ServeHTTP(w, r)
+	return w
+}
+
+func gunzip(t *testing.T, b []byte) string {
+	t.Helper()
+	zr, err := gzip.NewReader(bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	plain, err := io.ReadAll(zr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(plain)
+}
+
+func TestCompressResponses(t *testing.T) {
+	body := func(contentType, body string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", contentType)
+			io.WriteString(w, body)
+		}
+	}
+	long := strings.Repeat(`{"name":"Aaron Judge"}`, 4)
+	tests := []struct {
+		name           string
+		handler        http.HandlerFunc
+		acceptEncoding string
+		encoding       string
+		body           string
+	}{
+		{"large JSON", body("application/json", long), "gzip", "gzip", long},
+		{"below the minimum size", body("application/json", "[]"), "gzip", "", "[]"},
+		{"refused coding", body("application/json", long), "gzip;q=0", "", long},
+		{"incompressible type", body(exportContentTypes[exportParquet], long), "gzip", "", long},
+		{"not modified", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotModified)
+		}, "gzip", "", ""},
+	}
+	for _, tt := range tests {
+		w := compressed(tt.handler, tt.acceptEncoding)
+		if got := w.Header().Get("Content-Encoding"); got != tt.encoding {
+			t.Errorf("%s: Content-Encoding %q, want %q", tt.name, got, tt.encoding)
+			continue
+		}
+		got := w.Body.String()
+		if tt.encoding == "gzip" {
+			got = gunzip(t, w.Body.Bytes())
+		}
+		if got != tt.body {
+			t.Errorf("%s: body %q, want %q", tt.name, got, tt.body)
+		}
+		if !strings.Contains(strings.Join(w.Header().Values("Vary"), ","), "Accept-Encoding") {
+			t.Errorf("%s: Vary %v, want Accept-Encoding", tt.name, w.Header().Values("Vary"))
+		}
+	}
+}
+
+func TestCompressStreamsNDJSON(t *testing.T) {
+	read := make(chan struct{})
+	srv := httptest.NewServer(compressResponses(minCompressSize, compressibleTypes...)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		io.WriteString(w, `{"name":"Aaron Judge"}`+"\n")
+		w.(http.Flusher).Flush()
+		select {
+		case <-read: // the first row reached the client while the response was still open
+		case <-time.After(5 * time.Second):
+		}
+		io.WriteString(w, `{"name":"DJ LeMahieu"}`+"\n")
+	})))
+	defer srv.Close()
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Accept-Encoding", "gzip")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.Header.Get("Content-Encoding") != "gzip" {
+		t.Fatalf("Content-Encoding %q, want a flushed stream compressed below the minimum size", resp.Header.Get("Content-Encoding"))
+	}
+	zr, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := bufio.NewReader(zr)
+	first, err := lines.ReadString('\n')
+	close(read)
+	if err != nil || first != `{"name":"Aaron Judge"}`+"\n" {
+		t.Fatalf("first row %q %v", first, err)
+	}
+	if rest, err := io.ReadAll(lines); err != nil || string(rest) != `{"name":"DJ LeMahieu"}`+"\n" {
+		t.Errorf("rest of the stream %q %v", rest, err)
+	}
+}
+
+// trackedEncoder records when the middleware finishes with a pooled gzip encoder
+type trackedEncoder struct {
+	*gzip.Writer
+	closed, released bool
+}
+
+func (e *trackedEncoder) Close() error {
+	e.closed = true
+	return e.Writer.Close()
+}
+
+func (e *trackedEncoder) Reset(w io.Writer) {
+	e.released = w == nil
+	e.Writer.Reset(w)
+}
+
+func TestCompressReleasesEncoderOnPanic(t *testing.T) {
+	var created []*trackedEncoder
+	pool := encoderPools["gzip"]
+	encoderPools["gzip"] = &sync.Pool{New: func() interface{} {
+		e := &trackedEncoder{Writer: gzip.NewWriter(nil)}
+		created = append(created, e)
+		return e
+	}}
+	t.Cleanup(func() { encoderPools["gzip"] = pool })
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("want the handler's panic to reach the recoverer")
+			}
+		}()
+		compressed(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			io.WriteString(w, strings.Repeat("[]", 16))
+			panic("row scan failed")
+		}, "gzip")
+	}()
+	// close resets an encoder just before putting it back; sync.Pool gives no way to look inside
+	if len(created) != 1 || !created[0].closed || !created[0].released {
+		t.Errorf("encoders %+v, want the one encoder closed and released to the pool", created)
+	}
+}
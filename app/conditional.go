@@ -0,0 +1,65 @@
+package app
+
+import (
+	"context"
+	"crypto/sha1"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"gopkg.in/guregu/null.v3"
+)
+
+// etag derives a weak entity tag from the request path, its parameters, the response format and the newest
+// change behind the response; it is weak because compression may change the bytes of an equivalent response
+func etag(r *http.Request, format string, modified time.Time) string {
+	sum := sha1.Sum([]byte(fmt.Sprintf("%s?%s|%s|%d", r.URL.Path, r.URL.Query().Encode(), format, modified.UnixNano())))
+	return fmt.Sprintf(`W/"%x"`, sum[:12])
+}
+
+// etagMatch reports whether an If-None-Match header value matches tag using weak comparison
+func etagMatch(header, tag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(tag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+// notModified sets the ETag and Last-Modified validators for a response built from rows no newer than modified and
+// writes a 304 when the request's If-None-Match, or failing that If-Modified-Since, still matches
+func notModified(w http.ResponseWriter, r *http.Request, format string, modified time.Time) bool {
+	tag := etag(r, format, modified)
+	w.Header().Add("Vary", "Accept")
+	w.Header().Set("ETag", tag)
+	w.Header().Set("Last-Modified", modified.UTC().Format(http.TimeFormat))
+	match := false
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		match = etagMatch(inm, tag)
+	} else if ims, err := http.ParseTime(r.Header.Get("If-Modified-Since")); err == nil {
+		match = !modified.Truncate(time.Second).After(ims)
+	}
+	if match {
+		w.WriteHeader(http.StatusNotModified)
+	}
+	return match
+}
+
+// lastModified looks up the newest change to a stat table within snap for all teams or a single team, covering load
+// runs that rewrite a snapshot in place; it is null when the table has no matching rows
+func (s *Server) lastModified(ctx context.Context, table, team string, snap snapshot) (null.Time, error) {
+	return s.Repo.LastModified(ctx, table, team, snap)
+}
+
+// checkNotModified sets the validators for a stat table response and reports whether a 304 was written; no
+// validators are set when the table has no matching rows
+func (s *Server) checkNotModified(w http.ResponseWriter, r *http.Request, format, table, team string, snap snapshot) (bool, error) {
+	modified, err := s.lastModified(r.Context(), table, team, snap)
+	if err != nil || !modified.Valid {
+		return false, err
+	}
+	return notModified(w, r, format, modified.Time), nil
+}
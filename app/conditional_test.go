@@ -0,0 +1,69 @@
+package app
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestNotModified(t *testing.T) {
+	modified := time.Date(2020, 8, 8, 6, 30, 15, 500000000, time.UTC)
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/mlb/batting/NYY?fields=name", nil)
+	tag := etag(r, formatJSON, modified)
+	tests := []struct {
+		name   string
+		header map[string]string
+		want   bool
+	}{
+		{"unconditional", nil, false},
+		{"matching tag", map[string]string{"If-None-Match": tag}, true},
+		{"strong form of the tag", map[string]string{"If-None-Match": tag[len("W/"):]}, true},
+		{"tag in a list", map[string]string{"If-None-Match": `W/"0000", ` + tag}, true},
+		{"any tag", map[string]string{"If-None-Match": "*"}, true},
+		{"weak tag mismatch", map[string]string{"If-None-Match": `W/"0000"`}, false},
+		{"tag of an earlier snapshot", map[string]string{"If-None-Match": etag(r, formatJSON, modified.Add(-time.Hour))}, false},
+		{"tag of another format", map[string]string{"If-None-Match": etag(r, formatCSV, modified)}, false},
+		{"modified since the same second", map[string]string{"If-Modified-Since": modified.Format(http.TimeFormat)}, true},
+		{"modified since later", map[string]string{"If-Modified-Since": modified.Add(time.Hour).Format(http.TimeFormat)}, true},
+		{"modified since earlier", map[string]string{"If-Modified-Since": modified.Add(-time.Second).Format(http.TimeFormat)}, false},
+		{"unparseable date", map[string]string{"If-Modified-Since": "yesterday"}, false},
+		{"tag mismatch overrides date", map[string]string{
+			"If-None-Match": `W/"0000"`, "If-Modified-Since": modified.Add(time.Hour).Format(http.TimeFormat),
+		}, false},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/mlb/batting/NYY?fields=name", nil)
+		for name, value := range tt.header {
+			r.Header.Set(name, value)
+		}
+		w := httptest.NewRecorder()
+		got := notModified(w, r, formatJSON, modified)
+		if got != tt.want || (w.Code == http.StatusNotModified) != tt.want {
+			t.Errorf("%s: %v status %d, want %v", tt.name, got, w.Code, tt.want)
+		}
+		if w.Header().Get("ETag") != tag || w.Header().Get("Last-Modified") != "Sat, 08 Aug 2020 06:30:15 GMT" {
+			t.Errorf("%s: ETag %s Last-Modified %s", tt.name, w.Header().Get("ETag"), w.Header().Get("Last-Modified"))
+		}
+	}
+}
+
+func TestConditionalStats(t *testing.T) {
+	ts := newTestServer(t, fixtureRepository(t))
+	resp := ts.get("/api/v1/mlb/batting/NYY", http.StatusOK, nil)
+	tag, modified := resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")
+	if tag == "" || modified != "Sat, 08 Aug 2020 06:00:00 GMT" {
+		t.Fatalf("validators %q %q, want the 2020-08-08 snapshot", tag, modified)
+	}
+	if resp, _ := ts.do(http.MethodGet, "/api/v1/mlb/batting/NYY", "", "If-None-Match", tag); resp.StatusCode != http.StatusNotModified {
+		t.Errorf("matching ETag: %d, want 304", resp.StatusCode)
+	}
+	if resp, _ := ts.do(http.MethodGet, "/api/v1/mlb/batting/NYY", "", "If-Modified-Since", modified); resp.StatusCode != http.StatusNotModified {
+		t.Errorf("If-Modified-Since the snapshot: %d, want 304", resp.StatusCode)
+	}
+	// the 2020-08-01 snapshot has its own validators
+	resp, _ = ts.do(http.MethodGet, "/api/v1/mlb/batting/NYY?asof=2020-08-02", "", "If-None-Match", tag)
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Last-Modified") != "Sat, 01 Aug 2020 06:00:00 GMT" {
+		t.Errorf("asof 2020-08-02 with the latest ETag: %d %s, want the earlier snapshot", resp.StatusCode, resp.Header.Get("Last-Modified"))
+	}
+}
@@ -0,0 +1,130 @@
+package app
+
+import (
+	"fmt"
+	"sports-data-api/db"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// dialect adapts the generated SQL to a database driver. Query builders write ? placeholders, which are rebound to
+// the driver's bind type, qualify tables through table, and render the constructs without a common spelling
+// through the methods below
+type dialect struct {
+	bind      int    // sqlx bind type ? placeholders are rebound to
+	schema    string // qualifier for the baseballreference tables, from db.Schema
+	like      string // case-insensitive pattern match operator
+	fetch     string // clause limiting an ordered select to the rows of the following placeholder
+	nullsLast bool   // supports NULLS LAST in ORDER BY
+	rowValues bool   // supports (a, b) > (c, d) row value comparisons
+	trim      string // expression stripping the Baseball-Reference handedness markers (* and #) from %[1]s
+	userRole  string // select of the role of the user and password placeholders
+	userPass  string // select of the role and bcrypt hash of the user placeholder, where SQL cannot check bcrypt
+	runs      bool   // the schema can have the load_run tables of the migrations; cleared when it does not
+}
+
+var (
+	postgresDialect = dialect{
+		bind:      sqlx.DOLLAR,
+		schema:    db.Schema("postgres"),
+		like:      "ILIKE",
+		fetch:     "LIMIT ?",
+		nullsLast: true,
+		rowValues: true,
+		trim:      "rtrim(%[1]s, '*#')",
+		userRole:  "SELECT basic_auth.user_role(?, ?)",
+		runs:      true,
+	}
+	// mysql has no schemas within a database, so the tables and users are read from the configured database; LIKE
+	// is case insensitive under the default collations. Neither mysql nor SQL Server has bcrypt, so passwords are
+	// checked against the stored hash in Go
+	mysqlDialect = dialect{
+		bind:      sqlx.QUESTION,
+		schema:    db.Schema("mysql"),
+		like:      "LIKE",
+		fetch:     "LIMIT ?",
+		rowValues: true,
+		trim:      "TRIM(TRAILING '#' FROM TRIM(TRAILING '*' FROM %[1]s))",
+		userPass:  "SELECT role, pass FROM users WHERE email = ?",
+	}
+	sqlserverDialect = dialect{
+		bind:     sqlx.AT,
+		schema:   db.Schema("sqlserver"),
+		like:     "LIKE",
+		fetch:    "OFFSET 0 ROWS FETCH NEXT ? ROWS ONLY",
+		trim:     "CASE WHEN RIGHT(%[1]s, 1) IN ('*', '#') THEN LEFT(%[1]s, LEN(%[1]s) - 1) ELSE %[1]s END",
+		userPass: "SELECT role, pass FROM basic_auth.users WHERE email = ?",
+	}
+	// sqlite keeps every table in the main database and checks passwords with the crypt function db registers on
+	// each connection; the scalar subquery returns null rather than no row for unknown credentials
+	sqliteDialect = dialect{
+		bind:      sqlx.QUESTION,
+		schema:    db.Schema("sqlite"),
+		like:      "LIKE",
+		fetch:     "LIMIT ?",
+		nullsLast: true,
+		rowValues: true,
+		trim:      "rtrim(%[1]s, '*#')",
+		userRole:  "SELECT (SELECT role FROM users WHERE email = ? AND pass = crypt(?, pass))",
+		runs:      true,
+	}
+)
+
+// dialects are keyed by the database/sql driver name db.Container opens
+var dialects = map[string]dialect{
+	"postgres":  postgresDialect,
+	"mysql":     mysqlDialect,
+	"sqlserver": sqlserverDialect,
+	"sqlite":    sqliteDialect,
+}
+
+// dialectFor returns the dialect of a driver; the generated SQL has only been written for the drivers in dialects
+func dialectFor(driver string) (dialect, error) {
+	d, ok := dialects[driver]
+	if !ok {
+		return d, errors.Errorf("unsupported database driver: %s", driver)
+	}
+	return d, nil
+}
+
+// rebind rewrites the ? placeholders of a generated query for the driver
+func (d dialect) rebind(query string) string {
+	return sqlx.Rebind(d.bind, query)
+}
+
+// table qualifies a baseballreference table name
+func (d dialect) table(name string) string {
+	return d.schema + name
+}
+
+// orderNullsLast orders by expr in direction with nulls after every value
+func (d dialect) orderNullsLast(expr, direction string) string {
+	if d.nullsLast {
+		return fmt.Sprintf("%s %s NULLS LAST", expr, direction)
+	}
+	return fmt.Sprintf("CASE WHEN %[1]s IS NULL THEN 1 ELSE 0 END, %[1]s %[2]s", expr, direction)
+}
+
+// trimMarkers strips the handedness markers Baseball-Reference appends to player names from a name column
+func (d dialect) trimMarkers(column string) string {
+	return fmt.Sprintf(d.trim, column)
+}
+
+// visible appends the condition hiding the rows of a stat table aliased p written by a load run that has not landed
+// every table yet; rows written outside a run, such as by the Python scraper, are always visible
+func (d dialect) visible(where []string) []string {
+	if !d.runs {
+		return where
+	}
+	return append(where, fmt.Sprintf("NOT EXISTS (SELECT 1 FROM %s r WHERE r.id = p.runid AND r.completedat IS NULL)", d.table("load_run")))
+}
+
+// keyCompare compares the (teamid, id) page key of rows aliased x with a cursor using op (< or >), appending the
+// cursor to args
+func (d dialect) keyCompare(op string, teamid, id int, args []interface{}) (string, []interface{}) {
+	if d.rowValues {
+		return fmt.Sprintf("(x.teamid, x.id) %s (?, ?)", op), append(args, teamid, id)
+	}
+	return fmt.Sprintf("(x.teamid %[1]s ? OR (x.teamid = ? AND x.id %[1]s ?))", op), append(args, teamid, teamid, id)
+}
@@ -0,0 +1,243 @@
+package app
+
+import (
+	"math"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v3"
+)
+
+// parseDiffWindow reads the from and to query parameters as asof instants; the snapshot current at from is
+// the baseline (none when from is omitted) and the snapshot current at to is the end (latest when omitted)
+func parseDiffWindow(r *http.Request) (from, to snapshot, err error) {
+	return newDiffWindow(r.URL.Query().Get("from"), r.URL.Query().Get("to"))
+}
+
+// newDiffWindow builds the baseline and end bounds of a diff from asof instants; a bare date means end of day
+func newDiffWindow(fromParam, toParam string) (from, to snapshot, err error) {
+	var day bool
+	if fromParam != "" {
+		if from.to, day, err = parseTimeParam(fromParam); err != nil {
+			err = errors.Wrap(err, "invalid from parameter")
+			return
+		}
+		if day {
+			from.to = endOfDay(from.to)
+		}
+	}
+	if toParam != "" {
+		if to.to, day, err = parseTimeParam(toParam); err != nil {
+			err = errors.Wrap(err, "invalid to parameter")
+			return
+		}
+		if day {
+			to.to = endOfDay(to.to)
+		}
+	}
+	if !from.to.IsZero() && !to.to.IsZero() && !from.to.Before(to.to) {
+		err = errors.New("from must be before to")
+	}
+	return
+}
+
+// deltaInt subtracts a baseline value, treating a missing baseline as zero
+func deltaInt(to, from null.Int) null.Int {
+	if !to.Valid {
+		return null.Int{}
+	}
+	return null.IntFrom(to.Int64 - from.Int64)
+}
+
+// ratio divides two counting stats rounded to three places; null when the denominator is zero
+func ratio(num, den int64) null.Float {
+	if den == 0 {
+		return null.Float{}
+	}
+	return null.FloatFrom(round3(float64(num) / float64(den)))
+}
+
+func round3(f float64) float64 {
+	return math.Round(f*1000) / 1000
+}
+
+// ipToOuts converts innings pitched in baseball notation (6.1 = 6 1/3) to outs recorded
+func ipToOuts(ip null.Float) int64 {
+	whole := math.Floor(ip.Float64)
+	return int64(whole)*3 + int64(math.Round((ip.Float64-whole)*10))
+}
+
+// outsToIP converts outs recorded back to innings pitched in baseball notation
+func outsToIP(outs int64) float64 {
+	return float64(outs/3) + float64(outs%3)/10
+}
+
+// perNine scales a counting stat to a nine inning rate over outs recorded
+func perNine(stat, outs int64) null.Float {
+	if outs == 0 {
+		return null.Float{}
+	}
+	return null.FloatFrom(math.Round(float64(stat)*27/float64(outs)*100) / 100)
+}
+
+// playerKey joins a player's rows across snapshots on the name without the handedness markers (* and #)
+// Baseball-Reference appends, which can change between scrapes; history matches names the same way
+func playerKey(name string) string {
+	return strings.TrimRight(name, "*#")
+}
+
+func diffBatting(from, to []Batter) []BattingDelta {
+	baseline := make(map[string]Batter, len(from))
+	for _, b := range from {
+		baseline[playerKey(b.Name.String)] = b
+	}
+	deltas := make([]BattingDelta, 0, len(to))
+	for _, t := range to {
+		if !t.Name.Valid {
+			continue
+		}
+		f := baseline[playerKey(t.Name.String)]
+		d := BattingDelta{
+			Name:       t.Name,
+			Teamabbrev: t.Teamabbrev,
+			Fromdate:   f.Createddate,
+			Todate:     t.Createddate,
+			G:          deltaInt(t.G, f.G),
+			Pa:         deltaInt(t.Pa, f.Pa),
+			Ab:         deltaInt(t.Ab, f.Ab),
+			R:          deltaInt(t.R, f.R),
+			H:          deltaInt(t.H, f.H),
+			Twob:       deltaInt(t.Twob, f.Twob),
+			Threeb:     deltaInt(t.Threeb, f.Threeb),
+			Hr:         deltaInt(t.Hr, f.Hr),
+			Rbi:        deltaInt(t.Rbi, f.Rbi),
+			Sb:         deltaInt(t.Sb, f.Sb),
+			Cs:         deltaInt(t.Cs, f.Cs),
+			Bb:         deltaInt(t.Bb, f.Bb),
+			So:         deltaInt(t.So, f.So),
+			Tb:         deltaInt(t.Tb, f.Tb),
+			Gdp:        deltaInt(t.Gdp, f.Gdp),
+			Hbp:        deltaInt(t.Hbp, f.Hbp),
+			Sh:         deltaInt(t.Sh, f.Sh),
+			Sf:         deltaInt(t.Sf, f.Sf),
+			Ibb:        deltaInt(t.Ibb, f.Ibb),
+		}
+		d.Ba = ratio(d.H.Int64, d.Ab.Int64)
+		d.Obp = ratio(d.H.Int64+d.Bb.Int64+d.Hbp.Int64, d.Ab.Int64+d.Bb.Int64+d.Hbp.Int64+d.Sf.Int64)
+		d.Slg = ratio(d.Tb.Int64, d.Ab.Int64)
+		if d.Obp.Valid && d.Slg.Valid {
+			d.Ops = null.FloatFrom(round3(d.Obp.Float64 + d.Slg.Float64))
+		}
+		deltas = append(deltas, d)
+	}
+	return deltas
+}
+
+func diffPitching(from, to []Pitcher) []PitchingDelta {
+	baseline := make(map[string]Pitcher, len(from))
+	for _, p := range from {
+		baseline[playerKey(p.Name.String)] = p
+	}
+	deltas := make([]PitchingDelta, 0, len(to))
+	for _, t := range to {
+		if !t.Name.Valid {
+			continue
+		}
+		f := baseline[playerKey(t.Name.String)]
+		d := PitchingDelta{
+			Name:       t.Name,
+			Teamabbrev: t.Teamabbrev,
+			Fromdate:   f.Createddate,
+			Todate:     t.Createddate,
+			W:          deltaInt(t.W, f.W),
+			L:          deltaInt(t.L, f.L),
+			G:          deltaInt(t.G, f.G),
+			Gs:         deltaInt(t.Gs, f.Gs),
+			Gf:         deltaInt(t.Gf, f.Gf),
+			Cg:         deltaInt(t.Cg, f.Cg),
+			Sho:        deltaInt(t.Sho, f.Sho),
+			Sv:         deltaInt(t.Sv, f.Sv),
+			H:          deltaInt(t.H, f.H),
+			R:          deltaInt(t.R, f.R),
+			Er:         deltaInt(t.Er, f.Er),
+			Hr:         deltaInt(t.Hr, f.Hr),
+			Bb:         deltaInt(t.Bb, f.Bb),
+			Ibb:        deltaInt(t.Ibb, f.Ibb),
+			So:         deltaInt(t.So, f.So),
+			Hbp:        deltaInt(t.Hbp, f.Hbp),
+			Bk:         deltaInt(t.Bk, f.Bk),
+			Wp:         deltaInt(t.Wp, f.Wp),
+			Bf:         deltaInt(t.Bf, f.Bf),
+		}
+		var outs int64
+		if t.IP.Valid {
+			outs = ipToOuts(t.IP) - ipToOuts(f.IP)
+			d.IP = null.FloatFrom(outsToIP(outs))
+		}
+		d.Wl = ratio(d.W.Int64, d.W.Int64+d.L.Int64)
+		d.Era = perNine(d.Er.Int64, outs)
+		if outs != 0 {
+			d.Whip = null.FloatFrom(round3(float64(d.Bb.Int64+d.H.Int64) * 3 / float64(outs)))
+		}
+		d.H9 = perNine(d.H.Int64, outs)
+		d.Hr9 = perNine(d.Hr.Int64, outs)
+		d.Bb9 = perNine(d.Bb.Int64, outs)
+		d.So9 = perNine(d.So.Int64, outs)
+		d.Sow = ratio(d.So.Int64, d.Bb.Int64)
+		deltas = append(deltas, d)
+	}
+	return deltas
+}
+
+func diffBaserunning(from, to []Baserunner) []BaserunnerDelta {
+	baseline := make(map[string]Baserunner, len(from))
+	for _, b := range from {
+		baseline[playerKey(b.Name.String)] = b
+	}
+	deltas := make([]BaserunnerDelta, 0, len(to))
+	for _, t := range to {
+		if !t.Name.Valid {
+			continue
+		}
+		f := baseline[playerKey(t.Name.String)]
+		d := BaserunnerDelta{
+			Name:       t.Name,
+			Teamabbrev: t.Teamabbrev,
+			Fromdate:   f.Createddate,
+			Todate:     t.Createddate,
+			Pa:         deltaInt(t.Pa, f.Pa),
+			Roe:        deltaInt(t.Roe, f.Roe),
+			Xi:         deltaInt(t.Xi, f.Xi),
+			Sbo:        deltaInt(t.Sbo, f.Sbo),
+			Sb:         deltaInt(t.Sb, f.Sb),
+			Cs:         deltaInt(t.Cs, f.Cs),
+			Sb2:        deltaInt(t.Sb2, f.Sb2),
+			Cs2:        deltaInt(t.Cs2, f.Cs2),
+			Sb3:        deltaInt(t.Sb3, f.Sb3),
+			Cs3:        deltaInt(t.Cs3, f.Cs3),
+			Sbh:        deltaInt(t.Sbh, f.Sbh),
+			Csh:        deltaInt(t.Csh, f.Csh),
+			Po:         deltaInt(t.Po, f.Po),
+			Pcs:        deltaInt(t.Pcs, f.Pcs),
+			Oob:        deltaInt(t.Oob, f.Oob),
+			Oob1:       deltaInt(t.Oob1, f.Oob1),
+			Oob2:       deltaInt(t.Oob2, f.Oob2),
+			Oob3:       deltaInt(t.Oob3, f.Oob3),
+			Oobhm:      deltaInt(t.Oobhm, f.Oobhm),
+			Bt:         deltaInt(t.Bt, f.Bt),
+			Firsts:     deltaInt(t.Firsts, f.Firsts),
+			Firsts2:    deltaInt(t.Firsts2, f.Firsts2),
+			Firsts3:    deltaInt(t.Firsts3, f.Firsts3),
+			Firstd:     deltaInt(t.Firstd, f.Firstd),
+			Firstd3:    deltaInt(t.Firstd3, f.Firstd3),
+			Firstdh:    deltaInt(t.Firstdh, f.Firstdh),
+			Seconds:    deltaInt(t.Seconds, f.Seconds),
+			Seconds3:   deltaInt(t.Seconds3, f.Seconds3),
+			Secondsh:   deltaInt(t.Secondsh, f.Secondsh),
+		}
+		d.Sbpct = ratio(d.Sb.Int64, d.Sb.Int64+d.Cs.Int64)
+		deltas = append(deltas, d)
+	}
+	return deltas
+}
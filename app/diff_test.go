@@ -0,0 +1,180 @@
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	"gopkg.in/guregu/null.v3"
+)
+
+func TestIPToOuts(t *testing.T) {
+	tests := []struct {
+		ip   null.Float
+		outs int64
+	}{
+		{null.Float{}, 0},
+		{null.FloatFrom(0), 0},
+		{null.FloatFrom(6), 18},
+		{null.FloatFrom(6.1), 19},
+		{null.FloatFrom(6.2), 20},
+		{null.FloatFrom(0.1), 1},
+		{null.FloatFrom(31.2), 95},
+	}
+	for _, tt := range tests {
+		if got := ipToOuts(tt.ip); got != tt.outs {
+			t.Errorf("ipToOuts(%v) = %d, want %d", tt.ip.Float64, got, tt.outs)
+		}
+	}
+}
+
+func TestOutsToIP(t *testing.T) {
+	tests := []struct {
+		outs int64
+		ip   float64
+	}{
+		{0, 0},
+		{1, 0.1},
+		{18, 6},
+		{19, 6.1},
+		{20, 6.2},
+		{21, 7},
+		{-1, -0.1},
+	}
+	for _, tt := range tests {
+		if got := outsToIP(tt.outs); got != tt.ip {
+			t.Errorf("outsToIP(%d) = %v, want %v", tt.outs, got, tt.ip)
+		}
+	}
+}
+
+func TestPerNine(t *testing.T) {
+	tests := []struct {
+		stat, outs int64
+		want       null.Float
+	}{
+		{10, 27, null.FloatFrom(10)},
+		{1, 19, null.FloatFrom(1.42)},
+		{0, 20, null.FloatFrom(0)},
+		{5, 0, null.Float{}},
+	}
+	for _, tt := range tests {
+		if got := perNine(tt.stat, tt.outs); got != tt.want {
+			t.Errorf("perNine(%d, %d) = %v, want %v", tt.stat, tt.outs, got, tt.want)
+		}
+	}
+}
+
+func TestRatio(t *testing.T) {
+	tests := []struct {
+		num, den int64
+		want     null.Float
+	}{
+		{1, 3, null.FloatFrom(0.333)},
+		{2, 3, null.FloatFrom(0.667)},
+		{0, 4, null.FloatFrom(0)},
+		{3, 0, null.Float{}},
+	}
+	for _, tt := range tests {
+		if got := ratio(tt.num, tt.den); got != tt.want {
+			t.Errorf("ratio(%d, %d) = %v, want %v", tt.num, tt.den, got, tt.want)
+		}
+	}
+}
+
+func TestDiffBatting(t *testing.T) {
+	from := []Batter{
+		{Name: null.StringFrom("Judge"), G: null.IntFrom(20), Ab: null.IntFrom(74), H: null.IntFrom(20), Bb: null.IntFrom(10), Hbp: null.IntFrom(1), Sf: null.IntFrom(1), Tb: null.IntFrom(50)},
+		{Name: null.StringFrom("Bench"), G: null.IntFrom(3), Ab: null.IntFrom(4), H: null.IntFrom(1), Tb: null.IntFrom(1)},
+	}
+	to := []Batter{
+		{Name: null.StringFrom("Judge"), G: null.IntFrom(24), Ab: null.IntFrom(87), H: null.IntFrom(23), Bb: null.IntFrom(12), Hbp: null.IntFrom(1), Sf: null.IntFrom(1), Tb: null.IntFrom(58)},
+		{Name: null.StringFrom("Bench*"), G: null.IntFrom(5), Ab: null.IntFrom(4), H: null.IntFrom(1), Tb: null.IntFrom(1)}, // marker added
+		{Name: null.StringFrom("Callup"), G: null.IntFrom(2), Ab: null.IntFrom(8), H: null.IntFrom(2), Tb: null.IntFrom(5)},
+		{Name: null.String{}},
+	}
+	deltas := diffBatting(from, to)
+	if len(deltas) != 3 {
+		t.Fatalf("got %d deltas, want 3", len(deltas))
+	}
+	tests := []struct {
+		name         string
+		g, ab, h     int64
+		ba, obp, slg null.Float
+		ops          null.Float
+	}{
+		{"Judge", 4, 13, 3, null.FloatFrom(0.231), null.FloatFrom(0.333), null.FloatFrom(0.615), null.FloatFrom(0.948)},
+		{"Bench*", 2, 0, 0, null.Float{}, null.Float{}, null.Float{}, null.Float{}},
+		{"Callup", 2, 8, 2, null.FloatFrom(0.25), null.FloatFrom(0.25), null.FloatFrom(0.625), null.FloatFrom(0.875)},
+	}
+	for i, tt := range tests {
+		d := deltas[i]
+		if d.Name.String != tt.name || d.G.Int64 != tt.g || d.Ab.Int64 != tt.ab || d.H.Int64 != tt.h {
+			t.Errorf("%s: got %s g %d ab %d h %d, want g %d ab %d h %d", tt.name, d.Name.String, d.G.Int64, d.Ab.Int64, d.H.Int64, tt.g, tt.ab, tt.h)
+		}
+		if d.Ba != tt.ba || d.Obp != tt.obp || d.Slg != tt.slg || d.Ops != tt.ops {
+			t.Errorf("%s: got ba %v obp %v slg %v ops %v, want %v %v %v %v", tt.name, d.Ba, d.Obp, d.Slg, d.Ops, tt.ba, tt.obp, tt.slg, tt.ops)
+		}
+	}
+}
+
+func TestDiffPitching(t *testing.T) {
+	from := []Pitcher{
+		{Name: null.StringFrom("Cole"), W: null.IntFrom(1), L: null.IntFrom(0), IP: null.FloatFrom(6.2), H: null.IntFrom(4), Er: null.IntFrom(2), Bb: null.IntFrom(1), So: null.IntFrom(8)},
+		{Name: null.StringFrom("Closer"), IP: null.FloatFrom(2.1), Er: null.IntFrom(1)},
+	}
+	to := []Pitcher{
+		{Name: null.StringFrom("Cole"), W: null.IntFrom(2), L: null.IntFrom(1), IP: null.FloatFrom(12.1), H: null.IntFrom(10), Er: null.IntFrom(5), Bb: null.IntFrom(3), So: null.IntFrom(14)},
+		{Name: null.StringFrom("Closer"), IP: null.FloatFrom(2.1), Er: null.IntFrom(1)},
+	}
+	deltas := diffPitching(from, to)
+	if len(deltas) != 2 {
+		t.Fatalf("got %d deltas, want 2", len(deltas))
+	}
+	cole := deltas[0]
+	if cole.IP != null.FloatFrom(5.2) {
+		t.Errorf("ip = %v, want 5.2", cole.IP)
+	}
+	if cole.Era != null.FloatFrom(4.76) || cole.Whip != null.FloatFrom(1.412) || cole.Wl != null.FloatFrom(0.5) {
+		t.Errorf("era %v whip %v wl %v, want 4.76 1.412 0.5", cole.Era, cole.Whip, cole.Wl)
+	}
+	if cole.So9 != null.FloatFrom(9.53) || cole.Sow != null.FloatFrom(3) {
+		t.Errorf("so9 %v sow %v, want 9.53 3", cole.So9, cole.Sow)
+	}
+	closer := deltas[1]
+	if closer.IP != null.FloatFrom(0) || closer.Era.Valid || closer.Whip.Valid || closer.Wl.Valid || closer.Sow.Valid {
+		t.Errorf("idle pitcher: ip %v era %v whip %v wl %v sow %v, want 0 and nulls", closer.IP, closer.Era, closer.Whip, closer.Wl, closer.Sow)
+	}
+}
+
+func TestGetDiff(t *testing.T) {
+	for backend, ts := range testServers(t) {
+		var deltas []BattingDelta
+		ts.get("/api/v1/mlb/batting/NYY/diff?from=2020-08-01&to=2020-08-08", http.StatusOK, &deltas)
+		if len(deltas) != 2 {
+			t.Fatalf("%s: got %d deltas, want 2", backend, len(deltas))
+		}
+		for _, d := range deltas {
+			if !d.Fromdate.Valid || !d.Todate.Valid {
+				t.Errorf("%s: %s: fromdate %v todate %v, want both set", backend, d.Name.String, d.Fromdate, d.Todate)
+			}
+			if d.Name.String == "Aaron Judge" && (d.G.Int64 != 4 || d.Pa.Int64 != 15 || d.Ba != null.FloatFrom(0.231)) {
+				t.Errorf("%s: Aaron Judge: g %d pa %d ba %v, want 4 15 0.231", backend, d.G.Int64, d.Pa.Int64, d.Ba)
+			}
+		}
+		// NYY pitching has no snapshot at or before from, so there is no baseline to subtract
+		if p := ts.problem("/api/v1/mlb/pitching/NYY/diff?from=2020-08-01&to=2020-08-08", http.StatusNotFound); p.Code != codeNotFound {
+			t.Errorf("%s: code %q, want %q", backend, p.Code, codeNotFound)
+		}
+		// both bounds fall between the 08-01 and 08-08 snapshots
+		if p := ts.problem("/api/v1/mlb/batting/NYY/diff?from=2020-08-02&to=2020-08-07", http.StatusUnprocessableEntity); p.Code != codeInvalidParameter {
+			t.Errorf("%s: code %q, want %q", backend, p.Code, codeInvalidParameter)
+		}
+		ts.problem("/api/v1/mlb/batting/NYY/diff?from=2020-08-08&to=2020-08-01", http.StatusBadRequest)
+		// without from the season to date is the delta
+		var season []PitchingDelta
+		ts.get("/api/v1/mlb/pitching/NYY/diff", http.StatusOK, &season)
+		if len(season) != 1 || season[0].Fromdate.Valid || season[0].IP != null.FloatFrom(31.2) {
+			t.Errorf("%s: got %+v, want Gerrit Cole's season", backend, season)
+		}
+	}
+}
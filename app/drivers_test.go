@@ -0,0 +1,72 @@
+package app
+
+import (
+	"os"
+	"testing"
+
+	"sports-data-api/db"
+)
+
+// driverContainers are the databases docker-compose.test.yml starts, connected to through a database every server
+// has; SDA_TEST_DB_HOST points the tests at a docker host other than localhost
+var driverContainers = map[string]db.Dbconfig{
+	"mysql":     {Rdbms: "mysql", Port: "3306", User: "root", Database: "mysql"},
+	"sqlserver": {Rdbms: "sqlserver", Port: "1433", User: "sa", Database: "master"},
+}
+
+// driverPassword is the password of the administrators of the test containers
+const driverPassword = "Sda-test-1"
+
+// driverDatabase creates an empty sda_test database in the container of an rdbms and opens it, skipping the test
+// when the container is not running
+func driverDatabase(t *testing.T, rdbms string) *db.Container {
+	t.Helper()
+	conf := driverContainers[rdbms]
+	conf.Host, conf.Password = "localhost", driverPassword
+	if host := os.Getenv("SDA_TEST_DB_HOST"); host != "" {
+		conf.Host = host
+	}
+	admin := &db.Container{Conf: conf}
+	if err := admin.Open(); err != nil {
+		t.Skipf("no %s container; start it with docker compose -f docker-compose.test.yml up -d: %v", rdbms, err)
+	}
+	t.Cleanup(func() { admin.Close() })
+	for _, statement := range []string{"DROP DATABASE IF EXISTS sda_test", "CREATE DATABASE sda_test"} {
+		if _, err := admin.Db.Exec(statement); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Cleanup(func() {
+		if _, err := admin.Db.Exec("DROP DATABASE sda_test"); err != nil {
+			t.Error(err)
+		}
+	})
+	conf.Database = "sda_test"
+	dbc := &db.Container{Conf: conf}
+	if err := dbc.Open(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { dbc.Close() })
+	return dbc
+}
+
+// TestDriversMatchMemory runs the migrations and the generated queries against the MySQL and SQL Server containers,
+// checking each answers as the MemoryRepository does and that the migrations revert cleanly
+func TestDriversMatchMemory(t *testing.T) {
+	for rdbms := range driverContainers {
+		t.Run(rdbms, func(t *testing.T) {
+			dbc := driverDatabase(t, rdbms)
+			memory := newTestServer(t, fixtureRepository(t))
+			matchesMemory(t, rdbms, memory, newTestServer(t, fixtureDatabase(t, dbc)))
+			for {
+				m, ok, err := dbc.MigrateDown()
+				if err != nil {
+					t.Fatalf("MigrateDown %s: %v", m, err)
+				}
+				if !ok {
+					break
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,292 @@
+package app
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v3"
+)
+
+// response formats selected with ?format= or the Accept header
+const (
+	formatJSON   = "json"
+	formatCSV    = "csv"
+	formatTSV    = "tsv"
+	formatNDJSON = "ndjson"
+)
+
+// contentTypes maps each response format to its media type
+var contentTypes = map[string]string{
+	formatJSON:   "application/json",
+	formatCSV:    "text/csv; charset=utf-8",
+	formatTSV:    "text/tab-separated-values; charset=utf-8",
+	formatNDJSON: "application/x-ndjson",
+}
+
+// streaming reports whether a format is written straight from the result set rather than selected into a slice
+func streaming(format string) bool {
+	return format == formatCSV || format == formatTSV || format == formatNDJSON
+}
+
+// negotiateFormat picks the response format from the format parameter, then the Accept header, defaulting to JSON
+func negotiateFormat(r *http.Request) (string, error) {
+	if format := strings.ToLower(r.URL.Query().Get("format")); format != "" {
+		if _, ok := contentTypes[format]; !ok {
+			return "", errors.Errorf("unsupported format: %s", format)
+		}
+		return format, nil
+	}
+	for _, accept := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType := strings.TrimSpace(strings.Split(accept, ";")[0])
+		for format, contentType := range contentTypes {
+			if mediaType == strings.Split(contentType, ";")[0] {
+				return format, nil
+			}
+		}
+	}
+	return formatJSON, nil
+}
+
+// rowEncoder writes model structs one at a time; close must be called after the last row
+type rowEncoder interface {
+	encode(v reflect.Value) error
+	close() error
+}
+
+// newRowEncoder sets the Content-Type header for format and returns an encoder for rows of model restricted to
+// fields (all fields when nil); env wraps JSON output in the pagination envelope
+func newRowEncoder(w http.ResponseWriter, format string, model reflect.Type, fields []string, env *Page) rowEncoder {
+	w.Header().Set("Content-Type", contentTypes[format])
+	columns := jsonFields(model)
+	if fields != nil {
+		index := fieldIndex(model)
+		columns = make([]jsonField, len(fields))
+		for i, field := range fields {
+			columns[i] = jsonField{name: field, index: index[field]}
+		}
+	}
+	switch format {
+	case formatCSV, formatTSV:
+		enc := &delimitedEncoder{w: csv.NewWriter(w), columns: columns}
+		if format == formatTSV {
+			enc.w.Comma = '\t'
+		}
+		return enc
+	case formatNDJSON:
+		enc := &ndjsonEncoder{jsonEncoder: jsonEncoder{w: w, columns: columns, projected: fields != nil}}
+		enc.flusher, _ = w.(http.Flusher)
+		return enc
+	}
+	return &jsonEncoder{w: w, columns: columns, projected: fields != nil, env: env}
+}
+
+// jsonEncoder writes a JSON array, or a Page envelope around one
+type jsonEncoder struct {
+	w         io.Writer
+	columns   []jsonField
+	projected bool
+	env       *Page
+	opened    bool
+	rows      int
+}
+
+func (enc *jsonEncoder) open() error {
+	if enc.opened {
+		return nil
+	}
+	enc.opened = true
+	prefix := "["
+	if enc.env != nil {
+		prefix = `{"data":[`
+	}
+	_, err := io.WriteString(enc.w, prefix)
+	return err
+}
+
+func (enc *jsonEncoder) encode(v reflect.Value) error {
+	b, err := enc.marshal(v)
+	if err != nil {
+		return err
+	}
+	if err = enc.open(); err != nil {
+		return err
+	}
+	if enc.rows > 0 {
+		if _, err = io.WriteString(enc.w, ","); err != nil {
+			return err
+		}
+	}
+	enc.rows++
+	_, err = enc.w.Write(b)
+	return err
+}
+
+func (enc *jsonEncoder) marshal(v reflect.Value) ([]byte, error) {
+	if !enc.projected {
+		return json.Marshal(v.Interface())
+	}
+	p := projected{v: v, fields: make([][]int, len(enc.columns)), names: make([]string, len(enc.columns))}
+	for i, c := range enc.columns {
+		p.fields[i], p.names[i] = c.index, c.name
+	}
+	return p.MarshalJSON()
+}
+
+func (enc *jsonEncoder) close() error {
+	if err := enc.open(); err != nil {
+		return err
+	}
+	suffix := "]"
+	if enc.env != nil {
+		next, _ := json.Marshal(enc.env.Next)
+		prev, _ := json.Marshal(enc.env.Prev)
+		suffix = fmt.Sprintf(`],"next":%s,"prev":%s}`, next, prev)
+	}
+	_, err := io.WriteString(enc.w, suffix+"\n")
+	return err
+}
+
+// ndjsonEncoder writes one JSON object per line, flushing each to the client as it is written; pagination links
+// are only carried in the Link header
+type ndjsonEncoder struct {
+	jsonEncoder
+	flusher http.Flusher
+}
+
+func (enc *ndjsonEncoder) encode(v reflect.Value) error {
+	b, err := enc.marshal(v)
+	if err != nil {
+		return err
+	}
+	if _, err = enc.w.Write(append(b, '\n')); err != nil {
+		return err
+	}
+	if enc.flusher != nil {
+		enc.flusher.Flush()
+	}
+	return nil
+}
+
+func (enc *ndjsonEncoder) close() error {
+	return nil
+}
+
+// delimitedEncoder writes CSV or TSV with a header row of json field names; null values are empty cells
+type delimitedEncoder struct {
+	w       *csv.Writer
+	columns []jsonField
+	header  bool
+}
+
+func (enc *delimitedEncoder) writeHeader() error {
+	enc.header = true
+	names := make([]string, len(enc.columns))
+	for i, c := range enc.columns {
+		names[i] = c.name
+	}
+	return enc.w.Write(names)
+}
+
+func (enc *delimitedEncoder) encode(v reflect.Value) error {
+	if !enc.header {
+		if err := enc.writeHeader(); err != nil {
+			return err
+		}
+	}
+	record := make([]string, len(enc.columns))
+	for i, c := range enc.columns {
+		record[i] = cell(v.FieldByIndex(c.index))
+	}
+	if err := enc.w.Write(record); err != nil {
+		return err
+	}
+	enc.w.Flush() // hand each row to the ResponseWriter as it is read
+	return enc.w.Error()
+}
+
+func (enc *delimitedEncoder) close() error {
+	if !enc.header {
+		if err := enc.writeHeader(); err != nil {
+			return err
+		}
+	}
+	enc.w.Flush()
+	return enc.w.Error()
+}
+
+// cell renders a model field as a delimited value
+func cell(v reflect.Value) string {
+	switch x := v.Interface().(type) {
+	case null.String:
+		return x.String
+	case null.Int:
+		if x.Valid {
+			return strconv.FormatInt(x.Int64, 10)
+		}
+		return ""
+	case null.Float:
+		if x.Valid {
+			return strconv.FormatFloat(x.Float64, 'f', -1, 64)
+		}
+		return ""
+	case null.Time:
+		if x.Valid {
+			return x.Time.Format(time.RFC3339Nano)
+		}
+		return ""
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+// writeRows encodes every element of a slice of model structs; errors are logged and returned so callers can discard
+// partial output
+func writeRows(w http.ResponseWriter, format string, rows interface{}, fields []string, env *Page) error {
+	v := reflect.Indirect(reflect.ValueOf(rows))
+	enc := newRowEncoder(w, format, v.Type().Elem(), fields, env)
+	for i := 0; i < v.Len(); i++ {
+		if err := enc.encode(v.Index(i)); err != nil {
+			return logError(errors.Wrap(err, "error encoding response"))
+		}
+	}
+	if err := enc.close(); err != nil {
+		return logError(errors.Wrap(err, "error encoding response"))
+	}
+	return nil
+}
+
+// streamRows scans each row of an open result set into a new model struct and encodes it as soon as it is read;
+// errors are logged and returned
+func streamRows(w http.ResponseWriter, format string, rows Rows, model reflect.Type, fields []string) error {
+	defer rows.Close()
+	enc := newRowEncoder(w, format, model, fields, nil)
+	for rows.Next() {
+		v := reflect.New(model)
+		if err := rows.StructScan(v.Interface()); err != nil {
+			return logError(errors.Wrap(err, "error scanning row"))
+		}
+		if err := enc.encode(v.Elem()); err != nil {
+			return logError(errors.Wrap(err, "error encoding response"))
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return logError(errors.Wrap(err, "error reading rows"))
+	}
+	if err := enc.close(); err != nil {
+		return logError(errors.Wrap(err, "error encoding response"))
+	}
+	return nil
+}
+
+func logError(err error) error {
+	log.Println(err)
+	return err
+}
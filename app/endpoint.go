@@ -1,28 +1,19 @@
 package app
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"log"
 	"net/http"
+	"reflect"
 	"time"
-	
+
 	"github.com/dgrijalva/jwt-go"
 	"github.com/go-chi/chi"
+	"github.com/pkg/errors"
 )
 
-// Exception represents an error
-type Exception struct {
-	Status  int    `json:"status"`
-	Message string `json:"message"`
-}
-
-func checkWriteError(err error, status int, w http.ResponseWriter) bool {
-	if err != nil {
-		json.NewEncoder(w).Encode(Exception{Status: status, Message: err.Error()})
-		return true
-	}
-	return false
-}
-
 // GenerateToken validates API user creds and returns a JWT token string; endpoint - /user/generateToken
 func (s *Server) GenerateToken() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -30,10 +21,10 @@ func (s *Server) GenerateToken() http.HandlerFunc {
 		decoder := json.NewDecoder(r.Body)
 		decoder.DisallowUnknownFields()
 		err := decoder.Decode(&user)
-		if ok := checkWriteError(err, http.StatusInternalServerError, w); ok {
+		if ok := checkWriteError(w, r, err, http.StatusBadRequest, codeInvalidBody); ok {
 			return
 		}
-		if s.validateCredentials(user) {
+		if s.validateCredentials(r.Context(), user) {
 			expirationTime := time.Now().Add(72 * time.Hour)
 			// Create the JWT claims, which includes the username, password and expiration time
 			claims := &Claims{
@@ -44,7 +35,7 @@ func (s *Server) GenerateToken() http.HandlerFunc {
 			}
 			token := jwt.NewWithClaims(jwt.SigningMethodHS384, claims)
 			tokenString, err := token.SignedString([]byte(secretKey))
-			if ok := checkWriteError(err, http.StatusInternalServerError, w); ok {
+			if ok := checkWriteError(w, r, err, http.StatusInternalServerError, codeInternal); ok {
 				return
 			}
 			// http.SetCookie(w, &http.Cookie{
@@ -55,224 +46,447 @@ func (s *Server) GenerateToken() http.HandlerFunc {
 			json.NewEncoder(w).Encode(JwtToken{Token: tokenString})
 			return
 		}
-		json.NewEncoder(w).Encode(Exception{Status: http.StatusUnauthorized, Message: "error validating credentials"})
+		writeProblem(w, r, newAPIError(http.StatusUnauthorized, codeInvalidCredentials, errors.New("error validating credentials")))
 	}
 }
 
 // GetTeams fetches all teams currently in database; endpoint: /api/v1/mlb/teams
 func (s *Server) GetTeams() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		teams := []Team{}
-		err := s.Dbc.Db.Select(&teams, "SELECT id, teamname, teamabbrev FROM baseballreference.team")
-		if ok := checkWriteError(err, http.StatusInternalServerError, w); ok {
+		format, err := negotiateFormat(r)
+		if ok := checkWriteError(w, r, err, http.StatusBadRequest, codeInvalidParameter); ok {
 			return
 		}
-		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(teams)
+		teams, err := s.Repo.Teams(r.Context())
+		if ok := checkWriteError(w, r, err, http.StatusInternalServerError, codeDatabase); ok {
+			return
+		}
+		writeRows(w, format, teams, nil, nil)
 	}
 }
 
 // GetPitching fetches most recent pitching data for all teams or a specified MLB team; endpoint: /api/v1/mlb/pitching/{teamabbrev}
 func (s *Server) GetPitching() http.HandlerFunc {
+	return s.listStats(statTables["pitching"])
+}
+
+// GetBatting fetches most recent batting data for all teams or a specified MLB team; endpoint: /api/v1/mlb/batting/{teamabbrev}
+func (s *Server) GetBatting() http.HandlerFunc {
+	return s.listStats(statTables["batting"])
+}
+
+// GetBattingSplits fetches most recent batting_splits data for all teams or specified MLB team; endpoint: /api/v1/mlb/splits/batting/{teamabbrev}
+func (s *Server) GetBattingSplits() http.HandlerFunc {
+	return s.listStats(statTables["batting_splits"])
+}
+
+// GetPitchingSplits fetches most recent pitching_splits data for all teams or specified MLB team; endpoint: /api/v1/mlb/splits/pitching/{teamabbrev}
+func (s *Server) GetPitchingSplits() http.HandlerFunc {
+	return s.listStats(statTables["pitching_splits"])
+}
+
+// GetBaserunning fetches most recent baserunning data for all teams or specified MLB team; endpoint: /api/v1/mlb/baserunning/{teamabbrev}
+func (s *Server) GetBaserunning() http.HandlerFunc {
+	return s.listStats(statTables["baserunning"])
+}
+
+// GetBattingPitching fetches most recent opponent batting against each pitcher for all teams or specified MLB team; endpoint: /api/v1/mlb/opponents/batting/{teamabbrev}
+func (s *Server) GetBattingPitching() http.HandlerFunc {
+	return s.listStats(statTables["batting_pitching"])
+}
+
+// GetBattingHomeAway fetches most recent batting_home_away data for all teams or specified MLB team; endpoint: /api/v1/mlb/splits/homeaway/batting/{teamabbrev}
+func (s *Server) GetBattingHomeAway() http.HandlerFunc {
+	return s.listStats(statTables["batting_home_away"])
+}
+
+// GetPitchingHomeAway fetches most recent pitching_home_away data for all teams or specified MLB team; endpoint: /api/v1/mlb/splits/homeaway/pitching/{teamabbrev}
+func (s *Server) GetPitchingHomeAway() http.HandlerFunc {
+	return s.listStats(statTables["pitching_home_away"])
+}
+
+// listStats serves the latest snapshot of a stat table for all teams or the {teamabbrev} URL parameter;
+// asof/snapshot select a historical snapshot, fields projects columns, limit/cursor paginate the result into a
+// Page envelope, format (or the Accept header) selects JSON, NDJSON, CSV or TSV and any other parameter is a filter
+func (s *Server) listStats(table statTable) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		team := chi.URLParam(r, "teamabbrev")
-		pitchers := []Pitcher{}
-		var err error
-		if team != "" {
-			err = s.Dbc.Db.Select(
-				&pitchers,
-				`SELECT	id, teamabbrev, rk, pos, name, age, w, l, wl, era, g, gs, gf, cg, sho, sv, ip, h, r, 
-						er, hr, bb, ibb, so, hbp, bk, wp, bf, eraplus, fip, whip, h9, hr9, bb9, so9, sow, createddate
-				FROM 	(
-							SELECT	p.*, t.teamabbrev, DENSE_RANK() OVER(PARTITION BY teamid ORDER BY createddate DESC) AS rnk
-							FROM	baseballreference.pitching p
-									INNER JOIN baseballreference.team t ON t.id = p.teamid
-							WHERE	t.teamabbrev = $1
-						) x
-				WHERE rnk = 1`, 
-				team,
-			)
-		} else {
-			err = s.Dbc.Db.Select(
-				&pitchers,
-				`SELECT	id, teamabbrev, rk, pos, name, age, w, l, wl, era, g, gs, gf, cg, sho, sv, ip, h, r, 
-						er, hr, bb, ibb, so, hbp, bk, wp, bf, eraplus, fip, whip, h9, hr9, bb9, so9, sow, createddate
-				FROM 	(
-							SELECT	p.*, t.teamabbrev, DENSE_RANK() OVER(PARTITION BY teamid ORDER BY createddate DESC) AS rnk
-							FROM	baseballreference.pitching p
-									INNER JOIN baseballreference.team t ON t.id = p.teamid
-						) x
-				WHERE rnk = 1`,
-			)
+		format, err := negotiateFormat(r)
+		if ok := checkWriteError(w, r, err, http.StatusBadRequest, codeInvalidParameter); ok {
+			return
 		}
-		if ok := checkWriteError(err, http.StatusInternalServerError, w); ok {
+		snap, err := parseSnapshot(r)
+		if ok := checkWriteError(w, r, err, http.StatusBadRequest, codeInvalidParameter); ok {
 			return
 		}
-		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(pitchers)
+		fields, err := parseFields(r, table.model)
+		if ok := checkWriteError(w, r, err, http.StatusBadRequest, codeInvalidParameter); ok {
+			return
+		}
+		filters, err := parseFilters(r, table.model, listParams)
+		if ok := checkWriteError(w, r, err, http.StatusBadRequest, codeInvalidParameter); ok {
+			return
+		}
+		pg, err := parsePage(r)
+		if ok := checkWriteError(w, r, err, http.StatusBadRequest, codeInvalidParameter); ok {
+			return
+		}
+		team, err := s.resolveTeam(chi.URLParam(r, "teamabbrev"))
+		if err != nil {
+			writeProblem(w, r, err)
+			return
+		}
+		modified, err := s.lastModified(r.Context(), table.name, team, snap)
+		if ok := checkWriteError(w, r, err, http.StatusInternalServerError, codeDatabase); ok {
+			return
+		}
+		if modified.Valid && notModified(w, r, format, modified.Time) {
+			return
+		}
+		q := statQuery{
+			table:   table.name,
+			columns: table.selectList(fields),
+			team:    team,
+			snap:    snap,
+			filters: filters,
+			page:    pg,
+		}
+		s.serveCached(w, cacheKey(r, table.name, team, format), modified.Time, func(w http.ResponseWriter) error {
+			if pg == nil {
+				rows, err := s.Repo.Stats(r.Context(), q)
+				return writeQuery(w, r, format, table.model, fields, rows, err)
+			}
+			q.columns = pg.columns(q.columns, fields)
+			rows := reflect.New(reflect.SliceOf(table.model))
+			err := s.selectStats(r.Context(), q, rows.Interface())
+			if ok := checkWriteError(w, r, err, http.StatusInternalServerError, codeDatabase); ok {
+				return err
+			}
+			page, env := pg.paginate(r, rows.Elem())
+			if link := env.linkHeader(); link != "" {
+				w.Header().Set("Link", link)
+			}
+			return writeRows(w, format, page.Interface(), fields, &env)
+		})
 	}
 }
 
-// GetBatting fetches most recent batting data for all teams or a specified MLB team; endpoint: /api/v1/mlb/batting/{teamabbrev}
-func (s *Server) GetBatting() http.HandlerFunc {
+// writeQuery writes each row of a result set scanned into model in format, or the error that failed to produce
+// it; NDJSON and delimited formats stream straight from the result set while JSON is selected into a slice first
+func writeQuery(w http.ResponseWriter, r *http.Request, format string, model reflect.Type, fields []string, rows Rows, err error) error {
+	if ok := checkWriteError(w, r, err, http.StatusInternalServerError, codeDatabase); ok {
+		return err
+	}
+	if streaming(format) {
+		return streamRows(w, format, rows, model, fields)
+	}
+	selected, err := selectRows(rows, model)
+	if ok := checkWriteError(w, r, err, http.StatusInternalServerError, codeDatabase); ok {
+		return err
+	}
+	return writeRows(w, format, selected, fields, nil)
+}
+
+// selectStats selects the rows of q into dest, a pointer to a slice of model structs
+func (s *Server) selectStats(ctx context.Context, q statQuery, dest interface{}) error {
+	rows, err := s.Repo.Stats(ctx, q)
+	if err != nil {
+		return err
+	}
+	return selectAll(rows, dest)
+}
+
+// GetSnapshots lists the createddate snapshots available per table and team; endpoint: /api/v1/mlb/snapshots/{table}/{teamabbrev}
+func (s *Server) GetSnapshots() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		team := chi.URLParam(r, "teamabbrev")
-		batters := []Batter{}
-		var err error
-		if team != "" {
-			err = s.Dbc.Db.Select(
-				&batters,
-				`SELECT	id, teamabbrev, rk, pos, name, age, g, pa, ab, r, h, twob, threeb, hr, rbi, 
-						sb, cs, bb, so, ba, obp, slg, ops, opsplus, tb, gdp, hbp, sh, sf, ibb, createddate
-				FROM 	(
-							SELECT	p.*, t.teamabbrev, DENSE_RANK() OVER(PARTITION BY teamid ORDER BY createddate DESC) AS rnk
-							FROM	baseballreference.batting p
-									INNER JOIN baseballreference.team t ON t.id = p.teamid
-							WHERE	t.teamabbrev = $1
-						) x
-				WHERE rnk = 1`,
-				team,
-			)
-		} else {
-			err = s.Dbc.Db.Select(
-				&batters,
-				`SELECT	id, teamabbrev, rk, pos, name, age, g, pa, ab, r, h, twob, threeb, hr, rbi, 
-						sb, cs, bb, so, ba, obp, slg, ops, opsplus, tb, gdp, hbp, sh, sf, ibb, createddate
-				FROM 	(
-							SELECT	p.*, t.teamabbrev, DENSE_RANK() OVER(PARTITION BY teamid ORDER BY createddate DESC) AS rnk
-							FROM	baseballreference.batting p
-									INNER JOIN baseballreference.team t ON t.id = p.teamid
-						) x
-				WHERE rnk = 1`,
-			)
-		}
-		
-		if ok := checkWriteError(err, http.StatusInternalServerError, w); ok {
+		format, err := negotiateFormat(r)
+		if ok := checkWriteError(w, r, err, http.StatusBadRequest, codeInvalidParameter); ok {
 			return
 		}
-		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(batters)
+		tables := snapshotTables
+		if table := chi.URLParam(r, "table"); table != "" {
+			if !isSnapshotTable(table) {
+				writeProblem(w, r, newAPIError(http.StatusNotFound, codeUnknownTable, errors.New("unknown table: "+table)))
+				return
+			}
+			tables = []string{table}
+		}
+		team, err := s.resolveTeam(chi.URLParam(r, "teamabbrev"))
+		if err != nil {
+			writeProblem(w, r, err)
+			return
+		}
+		snapshots, err := s.Repo.Snapshots(r.Context(), tables, team)
+		if ok := checkWriteError(w, r, err, http.StatusInternalServerError, codeDatabase); ok {
+			return
+		}
+		writeRows(w, format, snapshots, nil, nil)
 	}
 }
 
-// GetBattingSplits fetches most recent batting_splits data for all teams or specified MLB team; endpoint: /api/v1/mlb/splits/batting/:teamabbrev
-func (s *Server) GetBattingSplits() http.HandlerFunc {
+// GetPlayerHistory fetches every snapshot row for a single player ordered by createddate; endpoint: /api/v1/mlb/players/{name}/history
+// Query parameters: stat (batting, pitching, baserunning or batting_pitching; default batting), from, to, fields and filters
+func (s *Server) GetPlayerHistory() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		team := chi.URLParam(r, "teamabbrev")
-		battingSplits := []BattingSplit{}
-		var err error
-		if team != "" {
-			err = s.Dbc.Db.Select(
-				&battingSplits,
-				`SELECT	id, teamabbrev, split, g, gs, pa, ab, r, h, twob, threeb, hr, rbi, sb, cs, bb, so, ba, 
-						obp, slg, ops, tb, gdp, hbp, sh, sf, ibb, roe, babip, topsplus, sopsplus, createddate
-				FROM 	(
-							SELECT	p.*, t.teamabbrev, DENSE_RANK() OVER(PARTITION BY teamid ORDER BY createddate DESC) AS rnk
-							FROM	baseballreference.batting_splits p
-									INNER JOIN baseballreference.team t ON t.id = p.teamid
-							WHERE	t.teamabbrev = $1
-						) x
-				WHERE rnk = 1`,
-				team,
-			)
-		} else {
-			err = s.Dbc.Db.Select(
-				&battingSplits,
-				`SELECT	id, teamabbrev, split, g, gs, pa, ab, r, h, twob, threeb, hr, rbi, sb, cs, bb, so, ba, 
-						obp, slg, ops, tb, gdp, hbp, sh, sf, ibb, roe, babip, topsplus, sopsplus, createddate
-				FROM 	(
-							SELECT	p.*, t.teamabbrev, DENSE_RANK() OVER(PARTITION BY teamid ORDER BY createddate DESC) AS rnk
-							FROM	baseballreference.batting_splits p
-									INNER JOIN baseballreference.team t ON t.id = p.teamid
-						) x
-				WHERE rnk = 1`,
-			)
+		format, err := negotiateFormat(r)
+		if ok := checkWriteError(w, r, err, http.StatusBadRequest, codeInvalidParameter); ok {
+			return
+		}
+		stat := r.URL.Query().Get("stat")
+		if stat == "" {
+			stat = "batting"
 		}
-		if ok := checkWriteError(err, http.StatusInternalServerError, w); ok {
+		table, ok := playerTables[stat]
+		if !ok {
+			writeProblem(w, r, newAPIError(http.StatusBadRequest, codeUnknownStat, errors.New("unknown stat: "+stat)))
 			return
 		}
-		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(battingSplits)
+		snap, err := parseRange(r)
+		if ok := checkWriteError(w, r, err, http.StatusBadRequest, codeInvalidParameter); ok {
+			return
+		}
+		fields, err := parseFields(r, table.model)
+		if ok := checkWriteError(w, r, err, http.StatusBadRequest, codeInvalidParameter); ok {
+			return
+		}
+		filters, err := parseFilters(r, table.model, historyParams)
+		if ok := checkWriteError(w, r, err, http.StatusBadRequest, codeInvalidParameter); ok {
+			return
+		}
+		notModified, err := s.checkNotModified(w, r, format, table.name, "", snap)
+		if ok := checkWriteError(w, r, err, http.StatusInternalServerError, codeDatabase); ok || notModified {
+			return
+		}
+		rows, err := s.Repo.History(r.Context(), table, table.selectList(fields), chi.URLParam(r, "name"), snap, filters)
+		writeQuery(w, r, format, table.model, fields, rows, err)
 	}
 }
 
-// GetPitchingSplits fetches most recent pitching_splits data for all teams or specified MLB team; endpoint: /api/v1/mlb/splits/batting/{teamabbrev}
-func (s *Server) GetPitchingSplits() http.HandlerFunc {
+// GetDiff fetches per-player stat deltas for a team between two snapshots of a batting, pitching or baserunning
+// table; endpoint: /api/v1/mlb/{table}/{teamabbrev}/diff?from=&to=
+// Without from the delta is the season to date; with it the team needs a snapshot at or before from that differs
+// from the one at to
+func (s *Server) GetDiff(table string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		team := chi.URLParam(r, "teamabbrev")
-		pitchingSplits := []PitchingSplit{}
-		var err error
-			if team != "" {
-				err = s.Dbc.Db.Select(
-					&pitchingSplits,
-					`SELECT	id, teamabbrev, split, g, pa, ab, r, h, twob, threeb, hr, sb, cs, bb, so, sow, ba, obp, slg, 
-							ops, tb, gdp, hbp, sh, sf, ibb, roe, babip, topsplus, sopsplus, createddate
-					FROM 	(
-								SELECT	p.*, t.teamabbrev, DENSE_RANK() OVER(PARTITION BY teamid ORDER BY createddate DESC) AS rnk
-								FROM	baseballreference.pitching_splits p
-										INNER JOIN baseballreference.team t ON t.id = p.teamid
-								WHERE	t.teamabbrev = $1
-							) x
-					WHERE rnk = 1`,
-					team,
-				)
-			} else {
-				err = s.Dbc.Db.Select(
-					&pitchingSplits,
-					`SELECT	id, teamabbrev, split, g, pa, ab, r, h, twob, threeb, hr, sb, cs, bb, so, sow, ba, obp, slg, 
-							ops, tb, gdp, hbp, sh, sf, ibb, roe, babip, topsplus, sopsplus, createddate
-					FROM 	(
-								SELECT	p.*, t.teamabbrev, DENSE_RANK() OVER(PARTITION BY teamid ORDER BY createddate DESC) AS rnk
-								FROM	baseballreference.pitching_splits p
-										INNER JOIN baseballreference.team t ON t.id = p.teamid
-							) x
-					WHERE rnk = 1`,
-				)
-			}
-		if ok := checkWriteError(err, http.StatusInternalServerError, w); ok {
+		format, err := negotiateFormat(r)
+		if ok := checkWriteError(w, r, err, http.StatusBadRequest, codeInvalidParameter); ok {
+			return
+		}
+		from, to, err := parseDiffWindow(r)
+		if ok := checkWriteError(w, r, err, http.StatusBadRequest, codeInvalidParameter); ok {
+			return
+		}
+		team, err := s.resolveTeam(chi.URLParam(r, "teamabbrev"))
+		if err != nil {
+			writeProblem(w, r, err)
+			return
+		}
+		if err := s.checkWindow(r.Context(), table, team, from, to); err != nil {
+			writeProblem(w, r, err)
+			return
+		}
+		notModified, err := s.checkNotModified(w, r, format, table, team, to)
+		if ok := checkWriteError(w, r, err, http.StatusInternalServerError, codeDatabase); ok || notModified {
+			return
+		}
+		var deltas interface{}
+		switch table {
+		case "batting":
+			start, end := []Batter{}, []Batter{}
+			err = s.selectWindow(r.Context(), &start, &end, statQuery{table: table, columns: battingColumns, team: team}, from, to)
+			deltas = diffBatting(start, end)
+		case "pitching":
+			start, end := []Pitcher{}, []Pitcher{}
+			err = s.selectWindow(r.Context(), &start, &end, statQuery{table: table, columns: pitchingColumns, team: team}, from, to)
+			deltas = diffPitching(start, end)
+		case "baserunning":
+			start, end := []Baserunner{}, []Baserunner{}
+			err = s.selectWindow(r.Context(), &start, &end, statQuery{table: table, columns: baserunningColumns, team: team}, from, to)
+			deltas = diffBaserunning(start, end)
+		}
+		if ok := checkWriteError(w, r, err, http.StatusInternalServerError, codeDatabase); ok {
+			return
+		}
+		writeRows(w, format, deltas, nil, nil)
+	}
+}
+
+// checkWindow rejects a diff without a baseline snapshot at or before from, or whose bounds resolve to the same
+// snapshot; a player missing from an existing baseline still counts from zero
+func (s *Server) checkWindow(ctx context.Context, table, team string, from, to snapshot) error {
+	if from.to.IsZero() {
+		return nil
+	}
+	snapshots, err := s.Repo.Snapshots(ctx, []string{table}, team)
+	if err != nil {
+		return newAPIError(http.StatusInternalServerError, codeDatabase, err)
+	}
+	start := from.newest(snapshots)
+	if !start.Valid {
+		return newAPIError(http.StatusNotFound, codeNotFound, errors.Errorf("no %s snapshot for %s at or before from", table, team))
+	}
+	if end := to.newest(snapshots); end.Valid && end.Time.Equal(start.Time) {
+		return newAPIError(http.StatusUnprocessableEntity, codeInvalidParameter, errors.New("from and to resolve to the same snapshot"))
+	}
+	return nil
+}
+
+// selectWindow selects the snapshots current at from and to; start is left empty when from is unbounded
+func (s *Server) selectWindow(ctx context.Context, start, end interface{}, q statQuery, from, to snapshot) error {
+	if !from.to.IsZero() {
+		q.snap = from
+		if err := s.selectStats(ctx, q, start); err != nil {
+			return err
+		}
+	}
+	q.snap = to
+	return s.selectStats(ctx, q, end)
+}
+
+// GetLeaders fetches a league-wide leaderboard from the latest batting or pitching snapshots; endpoint: /api/v1/mlb/leaders/{table}
+// Query parameters: sort (field, - prefix for descending), min_pa or min_ip, limit, asof, snapshot and filters.
+// limit bounds the rank rather than the row count: players tied at the last rank are all returned
+func (s *Server) GetLeaders(table string) http.HandlerFunc {
+	lb := leaderboards[table]
+	return func(w http.ResponseWriter, r *http.Request) {
+		format, err := negotiateFormat(r)
+		if ok := checkWriteError(w, r, err, http.StatusBadRequest, codeInvalidParameter); ok {
 			return
 		}
+		params, err := lb.parseLeaderParams(r)
+		if ok := checkWriteError(w, r, err, http.StatusBadRequest, codeInvalidParameter); ok {
+			return
+		}
+		snap, err := parseSnapshot(r)
+		if ok := checkWriteError(w, r, err, http.StatusBadRequest, codeInvalidParameter); ok {
+			return
+		}
+		filters, err := parseFilters(r, lb.table.model, lb.params())
+		if ok := checkWriteError(w, r, err, http.StatusBadRequest, codeInvalidParameter); ok {
+			return
+		}
+		modified, err := s.lastModified(r.Context(), lb.table.name, "", snap)
+		if ok := checkWriteError(w, r, err, http.StatusInternalServerError, codeDatabase); ok {
+			return
+		}
+		if modified.Valid && notModified(w, r, format, modified.Time) {
+			return
+		}
+		s.serveCached(w, cacheKey(r, "leaders_"+table, "", format), modified.Time, func(w http.ResponseWriter) error {
+			rows, err := s.Repo.Leaders(r.Context(), lb, params, snap, filters)
+			if ok := checkWriteError(w, r, err, http.StatusInternalServerError, codeDatabase); ok {
+				return err
+			}
+			leaders, err := selectRows(rows, lb.leader)
+			if ok := checkWriteError(w, r, err, http.StatusInternalServerError, codeDatabase); ok {
+				return err
+			}
+			return writeRows(w, format, leaders, nil, nil)
+		})
+	}
+}
+
+// GetCacheStats reports the response cache configuration and hit/miss counters; endpoint: /api/v1/admin/cache
+func (s *Server) GetCacheStats() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(pitchingSplits)
+		if err := json.NewEncoder(w).Encode(s.Cache.Stats()); err != nil {
+			log.Println(errors.Wrap(err, "error encoding response"))
+		}
 	}
 }
 
-// GetBaserunning fetches most recent baserunning data for all teamsspecified MLB team; endpoint: /api/v1/mlb/splits/baserunning/{teamabbrev}
-func (s *Server) GetBaserunning() http.HandlerFunc {
+// GetAudit fetches scraper audit entries filtered by team, table, status, from and to; endpoint: /api/v1/admin/audit
+func (s *Server) GetAudit() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		format, err := negotiateFormat(r)
+		if ok := checkWriteError(w, r, err, http.StatusBadRequest, codeInvalidParameter); ok {
+			return
+		}
+		f, err := parseAuditFilter(r)
+		if ok := checkWriteError(w, r, err, http.StatusBadRequest, codeInvalidParameter); ok {
+			return
+		}
+		if f.team, err = s.resolveTeam(f.team); err != nil {
+			writeProblem(w, r, err)
+			return
+		}
+		audits, err := s.Repo.Audit(r.Context(), f)
+		if ok := checkWriteError(w, r, err, http.StatusInternalServerError, codeDatabase); ok {
+			return
+		}
+		for i := range audits {
+			audits[i].Status = auditStatuses[audits[i].Statusid]
+		}
+		writeRows(w, format, audits, nil, nil)
+	}
+}
+
+// GetFreshness fetches the latest successful scrape per table for all teams or specified MLB team; endpoint: /api/v1/mlb/freshness/{teamabbrev}
+func (s *Server) GetFreshness() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		format, err := negotiateFormat(r)
+		if ok := checkWriteError(w, r, err, http.StatusBadRequest, codeInvalidParameter); ok {
+			return
+		}
+		team, err := s.resolveTeam(chi.URLParam(r, "teamabbrev"))
+		if err != nil {
+			writeProblem(w, r, err)
+			return
+		}
+		freshness, err := s.Repo.Freshness(r.Context(), team)
+		if ok := checkWriteError(w, r, err, http.StatusInternalServerError, codeDatabase); ok {
+			return
+		}
+		writeRows(w, format, freshness, nil, nil)
+	}
+}
+
+// GetExport writes a stat table as a Parquet or Arrow IPC file; endpoint: /api/v1/mlb/export/{table}
+// Query parameters: format (parquet or arrow; default parquet), team, from/to for every snapshot in a range or
+// asof/snapshot for a single snapshot (the latest when none are set)
+func (s *Server) GetExport() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		team := chi.URLParam(r, "teamabbrev")
-		baserunning := []Baserunner{}
-		var err error
-		if team != "" {
-			err = s.Dbc.Db.Select(&baserunning,
-				`SELECT	id, teamabbrev, name, age, pa, roe, xi, rspct, sbo, sb, cs, sbpct, sb2, cs2, sb3, cs3, sbh, csh, 
-						po, pcs, oob, oob1, oob2, oob3, oobhm, bt, xbtpct, firsts, firsts2, firsts3, firstd, firstd3, firstdh, seconds, seconds3, secondsh, createddate
-				FROM 	(
-							SELECT	p.*, t.teamabbrev, DENSE_RANK() OVER(PARTITION BY teamid ORDER BY createddate DESC) AS rnk
-							FROM	baseballreference.baserunning p
-									INNER JOIN baseballreference.team t ON t.id = p.teamid
-							WHERE	t.teamabbrev = $1
-						) x
-				WHERE rnk = 1`,
-				team,
-			)
+		table, ok := statTables[chi.URLParam(r, "table")]
+		if !ok {
+			writeProblem(w, r, newAPIError(http.StatusNotFound, codeUnknownTable, errors.New("unknown table: "+chi.URLParam(r, "table"))))
+			return
+		}
+		format, err := parseExportFormat(r)
+		if ok := checkWriteError(w, r, err, http.StatusBadRequest, codeInvalidParameter); ok {
+			return
+		}
+		team, err := s.resolveTeam(r.URL.Query().Get("team"))
+		if err != nil {
+			writeProblem(w, r, err)
+			return
+		}
+		ranged := r.URL.Query().Get("from") != "" || r.URL.Query().Get("to") != ""
+		var snap snapshot
+		if ranged {
+			snap, err = parseRange(r)
 		} else {
-			err = s.Dbc.Db.Select(
-				&baserunning,
-				`SELECT	id, teamabbrev, name, age, pa, roe, xi, rspct, sbo, sb, cs, sbpct, sb2, cs2, sb3, cs3, sbh, csh, 
-						po, pcs, oob, oob1, oob2, oob3, oobhm, bt, xbtpct, firsts, firsts2, firsts3, firstd, firstd3, firstdh, seconds, seconds3, secondsh, createddate
-				FROM 	(
-							SELECT	p.*, t.teamabbrev, DENSE_RANK() OVER(PARTITION BY teamid ORDER BY createddate DESC) AS rnk
-							FROM	baseballreference.baserunning p
-									INNER JOIN baseballreference.team t ON t.id = p.teamid
-						) x
-				WHERE rnk = 1`,
-			)
+			snap, err = parseSnapshot(r)
 		}
-		if ok := checkWriteError(err, http.StatusInternalServerError, w); ok {
+		if ok := checkWriteError(w, r, err, http.StatusBadRequest, codeInvalidParameter); ok {
 			return
 		}
-		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(baserunning)
+		notModified, err := s.checkNotModified(w, r, format, table.name, team, snap)
+		if ok := checkWriteError(w, r, err, http.StatusInternalServerError, codeDatabase); ok || notModified {
+			return
+		}
+		var rows Rows
+		if ranged {
+			rows, err = s.Repo.Range(r.Context(), table, team, snap)
+		} else {
+			rows, err = s.Repo.Stats(r.Context(), statQuery{table: table.name, columns: table.columns, team: team, snap: snap})
+		}
+		if ok := checkWriteError(w, r, err, http.StatusInternalServerError, codeDatabase); ok {
+			return
+		}
+		w.Header().Set("Content-Type", exportContentTypes[format])
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, exportFilename(table.name, team, format)))
+		if err = writeExport(w, format, rows, table.model); err != nil {
+			log.Println(errors.Wrap(err, "error writing export"))
+		}
 	}
 }
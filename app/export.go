@@ -0,0 +1,194 @@
+package app
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/apache/arrow/go/v12/arrow"
+	"github.com/apache/arrow/go/v12/arrow/array"
+	"github.com/apache/arrow/go/v12/arrow/ipc"
+	"github.com/apache/arrow/go/v12/arrow/memory"
+	"github.com/apache/arrow/go/v12/parquet"
+	"github.com/apache/arrow/go/v12/parquet/compress"
+	"github.com/apache/arrow/go/v12/parquet/pqarrow"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v3"
+)
+
+// export file formats selected with ?format=
+const (
+	exportParquet = "parquet"
+	exportArrow   = "arrow"
+)
+
+// exportContentTypes maps each export format to its media type
+var exportContentTypes = map[string]string{
+	exportParquet: "application/vnd.apache.parquet",
+	exportArrow:   "application/vnd.apache.arrow.file",
+}
+
+// exportBatchSize is the number of rows buffered into each Arrow record batch (and Parquet row group)
+const exportBatchSize = 8192
+
+// parseExportFormat reads the format parameter, defaulting to parquet
+func parseExportFormat(r *http.Request) (string, error) {
+	format := strings.ToLower(r.URL.Query().Get("format"))
+	if format == "" {
+		return exportParquet, nil
+	}
+	if _, ok := exportContentTypes[format]; !ok {
+		return "", errors.Errorf("unsupported export format: %s", format)
+	}
+	return format, nil
+}
+
+// arrowSchema maps the json-tagged fields of a model struct to Arrow fields; null.* types become nullable columns
+func arrowSchema(model reflect.Type) ([]jsonField, *arrow.Schema, error) {
+	fields := jsonFields(model)
+	schema := make([]arrow.Field, len(fields))
+	for i, f := range fields {
+		field := arrow.Field{Name: f.name}
+		switch model.FieldByIndex(f.index).Type {
+		case intType:
+			field.Type = arrow.PrimitiveTypes.Int64
+		case stringType:
+			field.Type = arrow.BinaryTypes.String
+		case nullInt:
+			field.Type, field.Nullable = arrow.PrimitiveTypes.Int64, true
+		case nullFloat:
+			field.Type, field.Nullable = arrow.PrimitiveTypes.Float64, true
+		case nullString:
+			field.Type, field.Nullable = arrow.BinaryTypes.String, true
+		case nullTime:
+			field.Type, field.Nullable = arrow.FixedWidthTypes.Timestamp_us, true
+		default:
+			return nil, nil, errors.Errorf("no arrow type for field %s", f.name)
+		}
+		schema[i] = field
+	}
+	return fields, arrow.NewSchema(schema, nil), nil
+}
+
+// appendRow appends one model struct to the column builders of a record
+func appendRow(b *array.RecordBuilder, columns []jsonField, v reflect.Value) {
+	for i, c := range columns {
+		switch x := v.FieldByIndex(c.index).Interface().(type) {
+		case int:
+			b.Field(i).(*array.Int64Builder).Append(int64(x))
+		case string:
+			b.Field(i).(*array.StringBuilder).Append(x)
+		case null.Int:
+			if x.Valid {
+				b.Field(i).(*array.Int64Builder).Append(x.Int64)
+			} else {
+				b.Field(i).AppendNull()
+			}
+		case null.Float:
+			if x.Valid {
+				b.Field(i).(*array.Float64Builder).Append(x.Float64)
+			} else {
+				b.Field(i).AppendNull()
+			}
+		case null.String:
+			if x.Valid {
+				b.Field(i).(*array.StringBuilder).Append(x.String)
+			} else {
+				b.Field(i).AppendNull()
+			}
+		case null.Time:
+			if x.Valid {
+				b.Field(i).(*array.TimestampBuilder).Append(arrow.Timestamp(x.Time.UnixNano() / 1000))
+			} else {
+				b.Field(i).AppendNull()
+			}
+		}
+	}
+}
+
+// recordWriter is satisfied by both the Arrow IPC file writer and the Parquet writer
+type recordWriter interface {
+	Write(rec arrow.Record) error
+	Close() error
+}
+
+// offsetWriter counts the bytes written so the Arrow file writer can ask for its position without a seekable stream
+type offsetWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (o *offsetWriter) Write(p []byte) (int, error) {
+	n, err := o.w.Write(p)
+	o.n += int64(n)
+	return n, err
+}
+
+// Seek only reports the current position
+func (o *offsetWriter) Seek(offset int64, whence int) (int64, error) {
+	if offset != 0 || whence != io.SeekCurrent {
+		return 0, errors.New("offsetWriter can only report its current position")
+	}
+	return o.n, nil
+}
+
+func newRecordWriter(w io.Writer, format string, schema *arrow.Schema) (recordWriter, error) {
+	if format == exportArrow {
+		return ipc.NewFileWriter(&offsetWriter{w: w}, ipc.WithSchema(schema), ipc.WithAllocator(memory.DefaultAllocator))
+	}
+	props := parquet.NewWriterProperties(parquet.WithCompression(compress.Codecs.Snappy))
+	return pqarrow.NewFileWriter(schema, w, props, pqarrow.DefaultWriterProps())
+}
+
+// writeExport scans each row of an open result set into model and writes them to w as record batches
+func writeExport(w io.Writer, format string, rows Rows, model reflect.Type) error {
+	defer rows.Close()
+	columns, schema, err := arrowSchema(model)
+	if err != nil {
+		return err
+	}
+	out, err := newRecordWriter(w, format, schema)
+	if err != nil {
+		return errors.Wrap(err, "error creating export writer")
+	}
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	defer builder.Release()
+	flush := func() error {
+		rec := builder.NewRecord()
+		defer rec.Release()
+		if rec.NumRows() == 0 {
+			return nil
+		}
+		return out.Write(rec)
+	}
+	var n int
+	for rows.Next() {
+		v := reflect.New(model)
+		if err = rows.StructScan(v.Interface()); err != nil {
+			return errors.Wrap(err, "error scanning row")
+		}
+		appendRow(builder, columns, v.Elem())
+		if n++; n%exportBatchSize == 0 {
+			if err = flush(); err != nil {
+				return errors.Wrap(err, "error writing record batch")
+			}
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return errors.Wrap(err, "error reading rows")
+	}
+	if err = flush(); err != nil {
+		return errors.Wrap(err, "error writing record batch")
+	}
+	return out.Close()
+}
+
+// exportFilename names the attachment after the table, team and format
+func exportFilename(table, team, format string) string {
+	if team != "" {
+		return fmt.Sprintf("%s_%s.%s", table, strings.ToLower(team), format)
+	}
+	return fmt.Sprintf("%s.%s", table, format)
+}
@@ -0,0 +1,158 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/v12/arrow"
+	"github.com/apache/arrow/go/v12/arrow/array"
+	"github.com/apache/arrow/go/v12/arrow/ipc"
+	"github.com/apache/arrow/go/v12/arrow/memory"
+	"github.com/apache/arrow/go/v12/parquet/file"
+	"github.com/apache/arrow/go/v12/parquet/pqarrow"
+	"gopkg.in/guregu/null.v3"
+)
+
+// readExport reads an Arrow IPC file or a Parquet file back into a table
+func readExport(t *testing.T, format string, b []byte) arrow.Table {
+	t.Helper()
+	if format == exportArrow {
+		r, err := ipc.NewFileReader(bytes.NewReader(b), ipc.WithAllocator(memory.DefaultAllocator))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer r.Close()
+		records := make([]arrow.Record, r.NumRecords())
+		for i := range records {
+			if records[i], err = r.Record(i); err != nil {
+				t.Fatal(err)
+			}
+			records[i].Retain() // the reader releases each record when it reads the next
+			defer records[i].Release()
+		}
+		return array.NewTableFromRecords(r.Schema(), records)
+	}
+	pf, err := file.NewParquetReader(bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pf.Close()
+	r, err := pqarrow.NewFileReader(pf, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	table, err := r.ReadTable(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return table
+}
+
+// column returns a named column of table as one array
+func column(t *testing.T, table arrow.Table, name string) arrow.Array {
+	t.Helper()
+	indices := table.Schema().FieldIndices(name)
+	if len(indices) != 1 {
+		t.Fatalf("no %s column in %s", name, table.Schema())
+	}
+	concatenated, err := array.Concatenate(table.Column(indices[0]).Data().Chunks(), memory.DefaultAllocator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return concatenated
+}
+
+func TestExportRoundTrip(t *testing.T) {
+	createddate := time.Date(2020, 8, 1, 6, 30, 0, 123456000, time.UTC)
+	n := exportBatchSize + 3 // spans a second record batch
+	rows := make([]reflect.Value, n)
+	for i := range rows {
+		b := Batter{ID: i, Teamabbrev: "NYY", Rk: i + 1, Createddate: null.TimeFrom(createddate)}
+		if i%3 != 0 {
+			b.Name = null.StringFrom("Aaron Judge")
+		}
+		if i%2 == 0 {
+			b.Ba = null.FloatFrom(0.25)
+		}
+		rows[i] = reflect.ValueOf(b)
+	}
+	_, schema, err := arrowSchema(reflect.TypeOf(Batter{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, format := range []string{exportArrow, exportParquet} {
+		t.Run(format, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := writeExport(&buf, format, &sliceRows{rows: rows}, reflect.TypeOf(Batter{})); err != nil {
+				t.Fatal(err)
+			}
+			table := readExport(t, format, buf.Bytes())
+			defer table.Release()
+			if table.NumRows() != int64(n) {
+				t.Errorf("%d rows, want %d", table.NumRows(), n)
+			}
+			if got := table.Schema(); len(got.Fields()) != len(schema.Fields()) {
+				t.Fatalf("schema %s, want %s", got, schema)
+			}
+			for i, want := range schema.Fields() {
+				got := table.Schema().Field(i)
+				if got.Name != want.Name || !arrow.TypeEqual(got.Type, want.Type) || got.Nullable != want.Nullable {
+					t.Errorf("field %d is %s, want %s", i, got, want)
+				}
+			}
+
+			name := column(t, table, "name").(*array.String)
+			defer name.Release()
+			if name.NullN() != (n+2)/3 || !name.IsNull(0) || name.Value(1) != "Aaron Judge" {
+				t.Errorf("name has %d nulls, first %q %q, want every third row null", name.NullN(), name.ValueStr(0), name.Value(1))
+			}
+			ba := column(t, table, "ba").(*array.Float64)
+			defer ba.Release()
+			if ba.NullN() != n/2 || ba.Value(0) != 0.25 || !ba.IsNull(1) {
+				t.Errorf("ba has %d nulls, want every odd row null", ba.NullN())
+			}
+			age := column(t, table, "age")
+			defer age.Release()
+			if age.NullN() != n {
+				t.Errorf("age has %d nulls, want all %d", age.NullN(), n)
+			}
+			id := column(t, table, "id").(*array.Int64)
+			defer id.Release()
+			if id.NullN() != 0 || id.Value(n-1) != int64(n-1) {
+				t.Errorf("id of the last row %d, want %d", id.Value(n-1), n-1)
+			}
+			created := column(t, table, "createddate").(*array.Timestamp)
+			defer created.Release()
+			if got := time.UnixMicro(int64(created.Value(n - 1))).UTC(); !got.Equal(createddate) {
+				t.Errorf("createddate %s, want %s", got, createddate)
+			}
+		})
+	}
+}
+
+func TestExportEndpoint(t *testing.T) {
+	ts := newTestServer(t, fixtureRepository(t))
+	var want []Batter
+	ts.get("/api/v1/mlb/batting/NYY", http.StatusOK, &want)
+	for _, format := range []string{exportArrow, exportParquet} {
+		resp, body := ts.do(http.MethodGet, "/api/v1/mlb/export/batting?team=nyy&format="+format, "")
+		if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != exportContentTypes[format] {
+			t.Fatalf("export %s: %d %s", format, resp.StatusCode, resp.Header.Get("Content-Type"))
+		}
+		if disposition := resp.Header.Get("Content-Disposition"); disposition != `attachment; filename="batting_nyy.`+format+`"` {
+			t.Errorf("export %s: Content-Disposition %s", format, disposition)
+		}
+		table := readExport(t, format, body)
+		if table.NumRows() != int64(len(want)) {
+			t.Errorf("export %s: %d rows, want the %d of /batting/NYY", format, table.NumRows(), len(want))
+		}
+		table.Release()
+	}
+	if resp, _ := ts.do(http.MethodGet, "/api/v1/mlb/export/batting?format=csv", ""); resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("export csv: %d, want 400", resp.StatusCode)
+	}
+}
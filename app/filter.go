@@ -0,0 +1,157 @@
+package app
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v3"
+)
+
+// filterOps maps the operator suffix of a filter parameter (hr[gte]=10) to its SQL comparison
+var filterOps = map[string]string{
+	"eq":   "=",
+	"ne":   "<>",
+	"gt":   ">",
+	"gte":  ">=",
+	"lt":   "<",
+	"lte":  "<=",
+	"like": "LIKE", // rendered as the dialect's case-insensitive match
+	"in":   "IN",
+}
+
+// reservedParams are the query parameters with their own meaning on some endpoint; they are never treated as
+// filters, and one an endpoint does not read is rejected rather than ignored
+var reservedParams = map[string]bool{
+	"fields":   true,
+	"asof":     true,
+	"snapshot": true,
+	"from":     true,
+	"to":       true,
+	"stat":     true,
+	"sort":     true,
+	"limit":    true,
+	"cursor":   true,
+	"format":   true,
+	"min_pa":   true,
+	"min_ip":   true,
+}
+
+// the reserved parameters read by the list and history endpoints; leaderboards add their sort and qualifier
+var (
+	listParams    = []string{"fields", "asof", "snapshot", "limit", "cursor", "format"}
+	historyParams = []string{"stat", "fields", "from", "to", "format"}
+)
+
+// filter is a single validated comparison against a model column
+type filter struct {
+	column string
+	op     string
+	values []interface{}
+}
+
+var (
+	stringType = reflect.TypeOf("")
+	intType    = reflect.TypeOf(0)
+	nullString = reflect.TypeOf(null.String{})
+	nullInt    = reflect.TypeOf(null.Int{})
+	nullFloat  = reflect.TypeOf(null.Float{})
+	nullTime   = reflect.TypeOf(null.Time{})
+)
+
+// parseFilters compiles every non-reserved query parameter into a filter validated against the json tags of model;
+// parameters take the form column=value or column[op]=value. params are the reserved parameters the endpoint reads
+func parseFilters(r *http.Request, model reflect.Type, params []string) ([]filter, error) {
+	query := r.URL.Query()
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys) // stable argument order keeps the generated SQL deterministic
+	filters := []filter{}
+	for _, key := range keys {
+		if contains(params, key) {
+			continue
+		}
+		if reservedParams[key] {
+			return nil, errors.Errorf("unsupported parameter: %s", key)
+		}
+		column, op := strings.ToLower(key), "eq"
+		if i := strings.Index(column, "["); i > 0 && strings.HasSuffix(column, "]") {
+			column, op = column[:i], column[i+1:len(column)-1]
+		}
+		for _, param := range query[key] {
+			f, err := newFilter(model, column, op, param)
+			if err != nil {
+				return nil, err
+			}
+			filters = append(filters, f)
+		}
+	}
+	return filters, nil
+}
+
+// newFilter validates a single comparison against the json tags of model; in takes a comma separated list
+func newFilter(model reflect.Type, column, op, param string) (filter, error) {
+	idx, ok := fieldIndex(model)[column]
+	if !ok {
+		return filter{}, errors.Errorf("unknown filter field: %s", column)
+	}
+	if _, ok := filterOps[op]; !ok {
+		return filter{}, errors.Errorf("unknown filter operator: %s", op)
+	}
+	fieldType := model.FieldByIndex(idx).Type
+	if op == "like" && fieldType != stringType && fieldType != nullString {
+		return filter{}, errors.Errorf("like filter requires a text field: %s", column)
+	}
+	raw := []string{param}
+	if op == "in" {
+		raw = strings.Split(param, ",")
+	}
+	f := filter{column: column, op: op}
+	for _, value := range raw {
+		v, err := filterValue(fieldType, value)
+		if err != nil {
+			return filter{}, errors.Wrapf(err, "invalid value for %s", column)
+		}
+		f.values = append(f.values, v)
+	}
+	return f, nil
+}
+
+// filterValue converts a parameter to the Go type of the model field it is compared against
+func filterValue(fieldType reflect.Type, value string) (interface{}, error) {
+	switch fieldType {
+	case intType, nullInt:
+		return strconv.ParseInt(value, 10, 64)
+	case nullFloat:
+		return strconv.ParseFloat(value, 64)
+	case nullTime:
+		t, _, err := parseTimeParam(value)
+		return t, err
+	}
+	return value, nil
+}
+
+// conditions appends each filter to a where clause over rows aliased x
+func conditions(d dialect, filters []filter, where []string, args []interface{}) ([]string, []interface{}) {
+	for _, f := range filters {
+		if f.op == "in" {
+			args = append(args, f.values...)
+			placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(f.values)), ", ")
+			where = append(where, fmt.Sprintf("x.%s IN (%s)", f.column, placeholders))
+			continue
+		}
+		op := filterOps[f.op]
+		if f.op == "like" {
+			op = d.like
+		}
+		args = append(args, f.values[0])
+		where = append(where, fmt.Sprintf("x.%s %s ?", f.column, op))
+	}
+	return where, args
+}
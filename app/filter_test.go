@@ -0,0 +1,133 @@
+package app
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseFilters(t *testing.T) {
+	model := reflect.TypeOf(Batter{})
+	day := time.Date(2020, 8, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		query   string
+		filters []filter
+		err     string
+	}{
+		{"", []filter{}, ""},
+		{"hr=10", []filter{{column: "hr", op: "eq", values: []interface{}{int64(10)}}}, ""},
+		{"HR[GTE]=10&ba[lt]=.3", []filter{
+			{column: "hr", op: "gte", values: []interface{}{int64(10)}},
+			{column: "ba", op: "lt", values: []interface{}{0.3}},
+		}, ""},
+		{"pos[in]=C,1B", []filter{{column: "pos", op: "in", values: []interface{}{"C", "1B"}}}, ""},
+		{"name[like]=%25judge", []filter{{column: "name", op: "like", values: []interface{}{"%judge"}}}, ""},
+		{"hr[gte]=5&hr[lte]=9", []filter{
+			{column: "hr", op: "gte", values: []interface{}{int64(5)}},
+			{column: "hr", op: "lte", values: []interface{}{int64(9)}},
+		}, ""},
+		{"createddate[gte]=2020-08-01", []filter{{column: "createddate", op: "gte", values: []interface{}{day}}}, ""},
+		{"fields=name&asof=2020-08-01&limit=5&cursor=x&format=csv&snapshot=latest", []filter{}, ""},
+		{"war=1", nil, "unknown filter field: war"},
+		{"hr[between]=1", nil, "unknown filter operator: between"},
+		{"hr[like]=1%25", nil, "like filter requires a text field: hr"},
+		{"hr=ten", nil, "invalid value for hr"},
+		{"hr[in]=1,x", nil, "invalid value for hr"},
+		{"sort=-hr", nil, "unsupported parameter: sort"},
+		{"min_pa=100", nil, "unsupported parameter: min_pa"},
+		{"from=2020-08-01", nil, "unsupported parameter: from"},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest(http.MethodGet, "/?"+tt.query, nil)
+		filters, err := parseFilters(r, model, listParams)
+		if tt.err != "" {
+			if err == nil || !strings.HasPrefix(err.Error(), tt.err) {
+				t.Errorf("%s: error %v, want %s", tt.query, err, tt.err)
+			}
+			continue
+		}
+		if err != nil || !reflect.DeepEqual(filters, tt.filters) {
+			t.Errorf("%s: got %+v %v, want %+v", tt.query, filters, err, tt.filters)
+		}
+	}
+	r := httptest.NewRequest(http.MethodGet, "/?stat=pitching&from=2020-08-01&to=2020-08-08", nil)
+	if _, err := parseFilters(r, model, historyParams); err != nil {
+		t.Errorf("history parameters: %v", err)
+	}
+}
+
+func TestParseFields(t *testing.T) {
+	model := reflect.TypeOf(Batter{})
+	tests := []struct {
+		query  string
+		fields []string
+		err    bool
+	}{
+		{"", nil, false},
+		{"fields=name,hr", []string{"name", "hr"}, false},
+		{"fields=NAME,%20hr,,name", []string{"name", "hr"}, false},
+		{"fields=name,war", nil, true},
+	}
+	for _, tt := range tests {
+		fields, err := parseFields(httptest.NewRequest(http.MethodGet, "/?"+tt.query, nil), model)
+		if (err != nil) != tt.err || !reflect.DeepEqual(fields, tt.fields) {
+			t.Errorf("%s: got %v %v, want %v", tt.query, fields, err, tt.fields)
+		}
+	}
+	if got := statTables["batting"].selectList([]string{"name", "hr"}); got != "name, hr" {
+		t.Errorf("selectList = %q", got)
+	}
+}
+
+func TestConditions(t *testing.T) {
+	filters := []filter{
+		{column: "hr", op: "gte", values: []interface{}{int64(10)}},
+		{column: "pos", op: "in", values: []interface{}{"C", "1B"}},
+		{column: "name", op: "like", values: []interface{}{"%judge"}},
+	}
+	tests := []struct {
+		dialect string
+		where   []string
+	}{
+		{"postgres", []string{"rnk = 1", "x.hr >= ?", "x.pos IN (?, ?)", "x.name ILIKE ?"}},
+		{"mysql", []string{"rnk = 1", "x.hr >= ?", "x.pos IN (?, ?)", "x.name LIKE ?"}},
+		{"sqlserver", []string{"rnk = 1", "x.hr >= ?", "x.pos IN (?, ?)", "x.name LIKE ?"}},
+		{"sqlite", []string{"rnk = 1", "x.hr >= ?", "x.pos IN (?, ?)", "x.name LIKE ?"}},
+	}
+	for _, tt := range tests {
+		where, args := conditions(dialects[tt.dialect], filters, []string{"rnk = 1"}, []interface{}{"NYY"})
+		if !reflect.DeepEqual(where, tt.where) {
+			t.Errorf("%s: where %q, want %q", tt.dialect, where, tt.where)
+		}
+		if want := []interface{}{"NYY", int64(10), "C", "1B", "%judge"}; !reflect.DeepEqual(args, want) {
+			t.Errorf("%s: args %v, want %v", tt.dialect, args, want)
+		}
+	}
+}
+
+func TestFilterParameters(t *testing.T) {
+	for backend, ts := range testServers(t) {
+		var rows []map[string]interface{}
+		ts.get("/api/v1/mlb/batting?hr[gte]=10&fields=name,hr", http.StatusOK, &rows)
+		if len(rows) != 2 || rows[0]["name"] != "Mitch Moreland*" || rows[1]["name"] != "Aaron Judge" || len(rows[0]) != 2 {
+			t.Errorf("%s: got %v, want Moreland and Judge projected to name and hr", backend, rows)
+		}
+		for _, path := range []string{
+			"/api/v1/mlb/batting?sort=-hr",
+			"/api/v1/mlb/pitching?min_ip=10",
+			"/api/v1/mlb/batting/NYY?from=2020-08-01",
+			"/api/v1/mlb/batting?stat=pitching",
+			"/api/v1/mlb/players/Aaron%20Judge/history?asof=2020-08-01",
+			"/api/v1/mlb/players/Aaron%20Judge/history?limit=1",
+			"/api/v1/mlb/batting?war[gt]=1",
+			"/api/v1/mlb/batting?fields=war",
+		} {
+			if p := ts.problem(path, http.StatusBadRequest); p.Code != codeInvalidParameter {
+				t.Errorf("%s: GET %s: code %q, want %q", backend, path, p.Code, codeInvalidParameter)
+			}
+		}
+	}
+}
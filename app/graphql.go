@@ -0,0 +1,463 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/graphql-go/graphql"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v3"
+)
+
+// graphqlTables are the stat tables nested under Team and queryable league-wide, keyed by GraphQL field name
+var graphqlTables = []struct {
+	field string
+	table string
+}{
+	{"batting", "batting"},
+	{"pitching", "pitching"},
+	{"baserunning", "baserunning"},
+	{"battingSplits", "batting_splits"},
+	{"pitchingSplits", "pitching_splits"},
+	{"battingHomeAway", "batting_home_away"},
+	{"pitchingHomeAway", "pitching_home_away"},
+	{"battingPitching", "batting_pitching"},
+}
+
+// graphqlPlayerTables are the stat tables nested under Player, keyed by GraphQL field name
+var graphqlPlayerTables = []struct {
+	field string
+	table string
+}{
+	{"batting", "batting"},
+	{"pitching", "pitching"},
+	{"baserunning", "baserunning"},
+	{"battingPitching", "batting_pitching"},
+}
+
+// graphqlFilter is a single comparison on a stat field; op takes the same operators as the REST filter parameters
+var graphqlFilter = graphql.NewInputObject(graphql.InputObjectConfig{
+	Name: "StatFilter",
+	Fields: graphql.InputObjectConfigFieldMap{
+		"field": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+		"op":    &graphql.InputObjectFieldConfig{Type: graphql.String, DefaultValue: "eq"},
+		"value": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+	},
+})
+
+// graphqlRequest is the body of a GraphQL POST, or the parameters of a GET
+type graphqlRequest struct {
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables"`
+	OperationName string                 `json:"operationName"`
+}
+
+// graphqlScalar maps a model field type to its GraphQL scalar
+func graphqlScalar(fieldType reflect.Type) (graphql.Output, error) {
+	switch fieldType {
+	case intType, nullInt:
+		return graphql.Int, nil
+	case stringType, nullString:
+		return graphql.String, nil
+	case nullFloat:
+		return graphql.Float, nil
+	case nullTime:
+		return graphql.DateTime, nil
+	}
+	return nil, errors.Errorf("no graphql type for %s", fieldType)
+}
+
+// graphqlValue unwraps a model field for GraphQL serialization; invalid null.* values resolve to null
+func graphqlValue(v reflect.Value) interface{} {
+	switch x := v.Interface().(type) {
+	case null.Int:
+		if x.Valid {
+			return x.Int64
+		}
+		return nil
+	case null.Float:
+		if x.Valid {
+			return x.Float64
+		}
+		return nil
+	case null.String:
+		if x.Valid {
+			return x.String
+		}
+		return nil
+	case null.Time:
+		if x.Valid {
+			return x.Time
+		}
+		return nil
+	}
+	return v.Interface()
+}
+
+// graphqlObject builds an object type from the json-tagged fields of a model struct
+func graphqlObject(model reflect.Type) (*graphql.Object, error) {
+	fields := graphql.Fields{}
+	for _, f := range jsonFields(model) {
+		scalar, err := graphqlScalar(model.FieldByIndex(f.index).Type)
+		if err != nil {
+			return nil, err
+		}
+		index := f.index
+		fields[f.name] = &graphql.Field{
+			Type: scalar,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return graphqlValue(reflect.ValueOf(p.Source).FieldByIndex(index)), nil
+			},
+		}
+	}
+	return graphql.NewObject(graphql.ObjectConfig{Name: model.Name(), Fields: fields}), nil
+}
+
+// statArgs are the arguments accepted by every stat table field
+func statArgs() graphql.FieldConfigArgument {
+	return graphql.FieldConfigArgument{
+		"asof":     &graphql.ArgumentConfig{Type: graphql.String},
+		"snapshot": &graphql.ArgumentConfig{Type: graphql.String},
+		"filter":   &graphql.ArgumentConfig{Type: graphql.NewList(graphql.NewNonNull(graphqlFilter))},
+		"sort":     &graphql.ArgumentConfig{Type: graphql.String},
+		"limit":    &graphql.ArgumentConfig{Type: graphql.Int},
+	}
+}
+
+// graphqlSnapshot reads the asof and snapshot arguments
+func graphqlSnapshot(args map[string]interface{}) (snapshot, error) {
+	asof, _ := args["asof"].(string)
+	exact, _ := args["snapshot"].(string)
+	return newSnapshot(asof, exact)
+}
+
+// graphqlFilters validates the filter argument against the json tags of model
+func graphqlFilters(args map[string]interface{}, model reflect.Type) ([]filter, error) {
+	list, _ := args["filter"].([]interface{})
+	filters := []filter{}
+	for _, item := range list {
+		in, _ := item.(map[string]interface{})
+		column, _ := in["field"].(string)
+		op, _ := in["op"].(string)
+		value, _ := in["value"].(string)
+		f, err := newFilter(model, strings.ToLower(column), strings.ToLower(op), value)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, f)
+	}
+	return filters, nil
+}
+
+// graphqlError withholds the detail of an internal error from the errors of a GraphQL response, as writeProblem
+// does for 5xx problems, logging it instead; client errors are returned as they are
+func graphqlError(err error) error {
+	if e, ok := err.(*apiError); ok && e.status < http.StatusInternalServerError {
+		return err
+	}
+	log.Println(errors.Wrap(err, "graphql"))
+	return errors.New(http.StatusText(http.StatusInternalServerError))
+}
+
+// graphqlStatQuery builds the select of a stat table field from its arguments, for a team or every team when team
+// is empty
+func graphqlStatQuery(table statTable, team string, args map[string]interface{}) (statQuery, error) {
+	snap, err := graphqlSnapshot(args)
+	if err != nil {
+		return statQuery{}, err
+	}
+	filters, err := graphqlFilters(args, table.model)
+	if err != nil {
+		return statQuery{}, err
+	}
+	q := statQuery{
+		table:   table.name,
+		columns: table.selectList(nil),
+		team:    team,
+		snap:    snap,
+		filters: filters,
+	}
+	if sort, ok := args["sort"].(string); ok && sort != "" {
+		if q.sort, q.desc, err = parseSort(table.model, sort); err != nil {
+			return statQuery{}, err
+		}
+	}
+	if limit, ok := args["limit"].(int); ok {
+		if limit < 1 || limit > maxPageLimit {
+			return statQuery{}, errors.Errorf("limit must be between 1 and %d", maxPageLimit)
+		}
+		q.limit = limit
+	}
+	return q, nil
+}
+
+// resolveStats resolves a stat table field from the latest (or asof) snapshot rows of a team, or of every team
+// when team is empty
+func (s *Server) resolveStats(table statTable, team string, p graphql.ResolveParams) (interface{}, error) {
+	q, err := graphqlStatQuery(table, team, p.Args)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.Repo.Stats(p.Context, q)
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+	result, err := selectRows(rows, table.model)
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+	return result, nil
+}
+
+// graphqlBatches holds the league-wide stat selects of one GraphQL request. Once the request lists teams, the stat
+// fields nested under each team are answered from one select per table and arguments rather than one per team
+type graphqlBatches struct {
+	mu      sync.Mutex
+	league  bool
+	results map[string]graphqlBatch
+}
+
+// graphqlBatch is the result of a league-wide select, split by team
+type graphqlBatch struct {
+	byTeam map[string]reflect.Value
+	err    error
+}
+
+type graphqlBatchesKey struct{}
+
+// resolveTeamStats resolves a stat table field nested under a team, from the league-wide batch of the request when
+// it lists teams
+func (s *Server) resolveTeamStats(table statTable, team string, p graphql.ResolveParams) (interface{}, error) {
+	batches, _ := p.Context.Value(graphqlBatchesKey{}).(*graphqlBatches)
+	if batches == nil {
+		return s.resolveStats(table, team, p)
+	}
+	batches.mu.Lock()
+	defer batches.mu.Unlock()
+	if !batches.league {
+		return s.resolveStats(table, team, p)
+	}
+	key := table.name + fmt.Sprint(p.Args)
+	batch, ok := batches.results[key]
+	if !ok {
+		batch = s.leagueStats(table, p)
+		batches.results[key] = batch
+	}
+	if batch.err != nil {
+		return nil, batch.err
+	}
+	if rows, ok := batch.byTeam[team]; ok {
+		return rows.Interface(), nil
+	}
+	return reflect.MakeSlice(reflect.SliceOf(table.model), 0, 0).Interface(), nil
+}
+
+// leagueStats selects a stat table field for every team at once and splits the rows by team. The limit applies per
+// team, so it is left out of the select and applied to each team's rows, which keep the order of the select
+func (s *Server) leagueStats(table statTable, p graphql.ResolveParams) graphqlBatch {
+	q, err := graphqlStatQuery(table, "", p.Args)
+	if err != nil {
+		return graphqlBatch{err: err}
+	}
+	limit := q.limit
+	q.limit = 0
+	rows, err := s.Repo.Stats(p.Context, q)
+	if err != nil {
+		return graphqlBatch{err: graphqlError(err)}
+	}
+	result, err := selectRows(rows, table.model)
+	if err != nil {
+		return graphqlBatch{err: graphqlError(err)}
+	}
+	all := reflect.ValueOf(result)
+	byTeam := map[string]reflect.Value{}
+	for i := 0; i < all.Len(); i++ {
+		team := all.Index(i).FieldByName("Teamabbrev").String()
+		rows, ok := byTeam[team]
+		if !ok {
+			rows = reflect.MakeSlice(all.Type(), 0, 0)
+		}
+		if limit == 0 || rows.Len() < limit {
+			byTeam[team] = reflect.Append(rows, all.Index(i))
+		}
+	}
+	return graphqlBatch{byTeam: byTeam}
+}
+
+// resolveHistory resolves a player stat table field from every snapshot of that player
+func (s *Server) resolveHistory(table statTable, name string, p graphql.ResolveParams) (interface{}, error) {
+	snap, err := graphqlSnapshot(p.Args)
+	if err != nil {
+		return nil, err
+	}
+	filters, err := graphqlFilters(p.Args, table.model)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.Repo.History(p.Context, table, table.selectList(nil), name, snap, filters)
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+	result, err := selectRows(rows, table.model)
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+	return result, nil
+}
+
+// graphqlSchema builds the schema: teams with their nested stat tables, league-wide stat tables and player
+// histories, all resolved against the same tables as the REST handlers
+func (s *Server) graphqlSchema() (graphql.Schema, error) {
+	objects := map[string]*graphql.Object{}
+	for _, t := range graphqlTables {
+		object, err := graphqlObject(statTables[t.table].model)
+		if err != nil {
+			return graphql.Schema{}, err
+		}
+		objects[t.table] = object
+	}
+	team, err := graphqlObject(reflect.TypeOf(Team{}))
+	if err != nil {
+		return graphql.Schema{}, err
+	}
+	rootFields := graphql.Fields{}
+	for _, t := range graphqlTables {
+		table := statTables[t.table]
+		team.AddFieldConfig(t.field, &graphql.Field{
+			Type: graphql.NewList(objects[t.table]),
+			Args: statArgs(),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return s.resolveTeamStats(table, p.Source.(Team).TeamAbbrev, p)
+			},
+		})
+		rootFields[t.field] = &graphql.Field{
+			Type: graphql.NewList(objects[t.table]),
+			Args: statArgs(),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return s.resolveStats(table, "", p)
+			},
+		}
+	}
+	playerFields := graphql.Fields{
+		"name": &graphql.Field{
+			Type: graphql.String,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source, nil
+			},
+		},
+	}
+	for _, t := range graphqlPlayerTables {
+		table := statTables[t.table]
+		args := statArgs()
+		delete(args, "sort")
+		delete(args, "limit")
+		playerFields[t.field] = &graphql.Field{
+			Type: graphql.NewList(objects[t.table]),
+			Args: args,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return s.resolveHistory(table, p.Source.(string), p)
+			},
+		}
+	}
+	player := graphql.NewObject(graphql.ObjectConfig{Name: "Player", Fields: playerFields})
+	rootFields["teams"] = &graphql.Field{
+		Type: graphql.NewList(team),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			teams, err := s.Repo.Teams(p.Context)
+			if err != nil {
+				return nil, graphqlError(err)
+			}
+			if batches, ok := p.Context.Value(graphqlBatchesKey{}).(*graphqlBatches); ok {
+				batches.mu.Lock()
+				batches.league = true
+				batches.mu.Unlock()
+			}
+			return teams, nil
+		},
+	}
+	rootFields["team"] = &graphql.Field{
+		Type: team,
+		Args: graphql.FieldConfigArgument{
+			"abbrev": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			abbrev, err := s.resolveTeam(p.Args["abbrev"].(string))
+			if err != nil {
+				return nil, graphqlError(err)
+			}
+			teams, err := s.Repo.Teams(p.Context)
+			if err != nil {
+				return nil, graphqlError(err)
+			}
+			for _, t := range teams {
+				if t.TeamAbbrev == abbrev {
+					return t, nil
+				}
+			}
+			return nil, nil
+		},
+	}
+	rootFields["player"] = &graphql.Field{
+		Type: player,
+		Args: graphql.FieldConfigArgument{
+			"name": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return p.Args["name"], nil
+		},
+	}
+	return graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{Name: "Query", Fields: rootFields}),
+	})
+}
+
+// parseGraphQLRequest reads the query from a JSON POST body, or from the query, variables and operationName
+// parameters of a GET
+func parseGraphQLRequest(r *http.Request) (req graphqlRequest, err error) {
+	if r.Method == http.MethodPost {
+		if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+			err = errors.Wrap(err, "invalid graphql request body")
+		}
+	} else {
+		req.Query = r.URL.Query().Get("query")
+		req.OperationName = r.URL.Query().Get("operationName")
+		if variables := r.URL.Query().Get("variables"); variables != "" {
+			if err = json.Unmarshal([]byte(variables), &req.Variables); err != nil {
+				err = errors.Wrap(err, "invalid graphql variables")
+			}
+		}
+	}
+	if err == nil && req.Query == "" {
+		err = errors.New("missing graphql query")
+	}
+	return
+}
+
+// GraphQL executes a GraphQL query against schema, built by graphqlSchema over teams, players and the stat tables;
+// the stat fields of listed teams are batched per request. endpoint: /graphql
+func (s *Server) GraphQL(schema graphql.Schema) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, err := parseGraphQLRequest(r)
+		if ok := checkWriteError(w, r, err, http.StatusBadRequest, codeInvalidBody); ok {
+			return
+		}
+		result := graphql.Do(graphql.Params{
+			Schema:         schema,
+			RequestString:  req.Query,
+			VariableValues: req.Variables,
+			OperationName:  req.OperationName,
+			Context:        context.WithValue(r.Context(), graphqlBatchesKey{}, &graphqlBatches{results: map[string]graphqlBatch{}}),
+		})
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(result)
+		if err != nil {
+			log.Println(errors.Wrap(err, "error encoding response"))
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+// countingRepository counts the stat selects run against a repository
+type countingRepository struct {
+	StatsRepository
+	stats int
+}
+
+func (c *countingRepository) Stats(ctx context.Context, q statQuery) (Rows, error) {
+	c.stats++
+	return c.StatsRepository.Stats(ctx, q)
+}
+
+// graphqlResult is the body of a GraphQL response
+type graphqlResult struct {
+	Data   map[string]json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func postGraphQL(ts *testServer, query string) (int, graphqlResult) {
+	ts.t.Helper()
+	body, err := json.Marshal(graphqlRequest{Query: query})
+	if err != nil {
+		ts.t.Fatal(err)
+	}
+	resp, b := ts.do(http.MethodPost, "/graphql", string(body))
+	var result graphqlResult
+	if resp.StatusCode == http.StatusOK {
+		if err := json.Unmarshal(b, &result); err != nil {
+			ts.t.Fatalf("graphql response %s: %v", b, err)
+		}
+	}
+	return resp.StatusCode, result
+}
+
+func TestGraphQLBatchesTeamFields(t *testing.T) {
+	repo := &countingRepository{StatsRepository: fixtureRepository(t)}
+	ts := newTestServer(t, repo)
+	status, result := postGraphQL(ts, `{
+		teams { teamabbrev batting(sort: "-hr", limit: 1) { name hr } pitchingSplits { split } }
+	}`)
+	if status != http.StatusOK || len(result.Errors) != 0 {
+		t.Fatalf("teams: %d %v", status, result.Errors)
+	}
+	if repo.stats != 2 {
+		t.Errorf("%d stat selects for the teams, want one per field", repo.stats)
+	}
+	var teams []struct {
+		Teamabbrev string
+		Batting    []struct {
+			Name string
+			Hr   int
+		}
+	}
+	if err := json.Unmarshal(result.Data["teams"], &teams); err != nil {
+		t.Fatal(err)
+	}
+	leaders := map[string]string{}
+	for _, team := range teams {
+		for _, b := range team.Batting {
+			leaders[team.Teamabbrev] += b.Name
+		}
+	}
+	if leaders["BOS"] != "Mitch Moreland*" || leaders["NYY"] != "Aaron Judge" {
+		t.Errorf("home run leaders %v, want the limit applied per team", leaders)
+	}
+
+	repo.stats = 0
+	status, result = postGraphQL(ts, `{ team(abbrev: "nyy") { batting(sort: "-hr", limit: 1) { name } } }`)
+	if status != http.StatusOK || len(result.Errors) != 0 || repo.stats != 1 {
+		t.Errorf("team: %d %v after %d stat selects, want one", status, result.Errors, repo.stats)
+	}
+}
+
+func TestGraphQLRoute(t *testing.T) {
+	ts := newTestServer(t, fixtureRepository(t))
+	if status, _ := postGraphQL(ts, "{ teams { teamabbrev } }"); status != http.StatusOK {
+		t.Errorf("POST /graphql: %d, want 200", status)
+	}
+	if status, _ := postGraphQL(&testServer{t: t, url: ts.url}, "{ teams { teamabbrev } }"); status != http.StatusUnauthorized {
+		t.Errorf("POST /graphql without a token: %d, want 401", status)
+	}
+	if resp, _ := ts.do(http.MethodGet, "/api/v1/graphql?query={teams{teamabbrev}}", ""); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("GET /api/v1/graphql: %d, want 404", resp.StatusCode)
+	}
+}
+
+func TestGraphQLInternalError(t *testing.T) {
+	repo := sqliteRepository(t)
+	ts := newTestServer(t, repo)
+	repo.Db.Close()
+	for _, query := range []string{
+		"{ teams { teamabbrev } }",
+		`{ batting { name } }`,
+		`{ player(name: "Aaron Judge") { batting { hr } } }`,
+	} {
+		status, result := postGraphQL(ts, query)
+		if status != http.StatusOK || len(result.Errors) != 1 || result.Errors[0].Message != "Internal Server Error" {
+			t.Errorf("%s: %d %v, want the database error withheld", query, status, result.Errors)
+		}
+	}
+}
@@ -0,0 +1,604 @@
+package app
+
+import (
+	"context"
+	"log"
+	"net"
+	"net/http"
+	"reflect"
+	"sports-data-api/rpc"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"gopkg.in/guregu/null.v3"
+)
+
+// sportsData implements the SportsData gRPC service against the same tables and queries as the REST handlers
+type sportsData struct {
+	rpc.UnimplementedSportsDataServer
+	s *Server
+}
+
+// serveGRPC serves the SportsData service on addr alongside the chi router
+func (s *Server) serveGRPC(addr string) {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatal(errors.Wrapf(err, "error listening on %s", addr))
+	}
+	g := grpc.NewServer(grpc.UnaryInterceptor(grpcAuthenticateUnary), grpc.StreamInterceptor(grpcAuthenticateStream))
+	rpc.RegisterSportsDataServer(g, &sportsData{s: s})
+	log.Fatal(g.Serve(lis))
+}
+
+// grpcAuthenticated checks the bearer token in the authorization metadata, mirroring the Authenticate middleware
+func grpcAuthenticated(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return status.Error(codes.Unauthenticated, "no authorization metadata")
+	}
+	bearerTokenSlice := strings.Split(values[0], " ")
+	if len(bearerTokenSlice) != 2 {
+		return status.Error(codes.Unauthenticated, "empty authorization token")
+	}
+	if !authenticateToken(bearerTokenSlice[1]) {
+		return status.Error(codes.Unauthenticated, "invalid authorization token")
+	}
+	return nil
+}
+
+func grpcAuthenticateUnary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := grpcAuthenticated(ctx); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func grpcAuthenticateStream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := grpcAuthenticated(ss.Context()); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// grpcStatQuery validates a StatRequest the same way listStats validates query parameters
+func grpcStatQuery(table statTable, req *rpc.StatRequest) (q statQuery, err error) {
+	q = statQuery{table: table.name, columns: table.selectList(nil), team: req.GetTeamabbrev()}
+	if q.snap, err = newSnapshot(req.GetAsof(), req.GetSnapshot()); err != nil {
+		return
+	}
+	if q.filters, err = grpcFilters(table.model, req.GetFilters()); err != nil {
+		return
+	}
+	if sort := req.GetSort(); sort != "" {
+		if q.sort, q.desc, err = parseSort(table.model, sort); err != nil {
+			return
+		}
+	}
+	if limit := int(req.GetLimit()); limit != 0 {
+		if limit < 1 || limit > maxPageLimit {
+			err = errors.Errorf("limit must be between 1 and %d", maxPageLimit)
+			return
+		}
+		q.limit = limit
+	}
+	return
+}
+
+// grpcFilters validates request filters against the json tags of model; an empty op means eq
+func grpcFilters(model reflect.Type, in []*rpc.Filter) ([]filter, error) {
+	var filters []filter
+	for _, f := range in {
+		op := strings.ToLower(f.GetOp())
+		if op == "" {
+			op = "eq"
+		}
+		filter, err := newFilter(model, strings.ToLower(f.GetField()), op, f.GetValue())
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
+// grpcError converts an *apiError into a status with the matching gRPC code; any other error is internal and, as in
+// writeProblem, logged rather than sent to the client
+func grpcError(err error) error {
+	e, ok := err.(*apiError)
+	if !ok {
+		log.Println(errors.Wrap(err, "grpc"))
+		return status.Error(codes.Internal, "internal error")
+	}
+	switch e.status {
+	case http.StatusBadRequest:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case http.StatusNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case http.StatusUnprocessableEntity:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	log.Println(errors.Wrap(e.err, "grpc"))
+	return status.Error(codes.Internal, "internal error")
+}
+
+// protoRow copies the json-tagged fields of a model struct into the same-named fields of a protobuf message; null.*
+// fields become wrapper messages that are left unset when invalid
+func protoRow(m protoreflect.Message, v reflect.Value, columns []jsonField) {
+	fields := m.Descriptor().Fields()
+	for _, c := range columns {
+		fd := fields.ByName(protoreflect.Name(c.name))
+		if fd == nil {
+			continue
+		}
+		switch x := v.FieldByIndex(c.index).Interface().(type) {
+		case int:
+			m.Set(fd, protoreflect.ValueOfInt64(int64(x)))
+		case string:
+			m.Set(fd, protoreflect.ValueOfString(x))
+		case null.Int:
+			if x.Valid {
+				m.Set(fd, protoreflect.ValueOfMessage(wrapperspb.Int64(x.Int64).ProtoReflect()))
+			}
+		case null.Float:
+			if x.Valid {
+				m.Set(fd, protoreflect.ValueOfMessage(wrapperspb.Double(x.Float64).ProtoReflect()))
+			}
+		case null.String:
+			if x.Valid {
+				m.Set(fd, protoreflect.ValueOfMessage(wrapperspb.String(x.String).ProtoReflect()))
+			}
+		case null.Time:
+			if x.Valid {
+				m.Set(fd, protoreflect.ValueOfMessage(timestamppb.New(x.Time).ProtoReflect()))
+			}
+		}
+	}
+}
+
+// eachStat runs the query for a StatRequest and passes every row, converted to a new message of the same type as
+// template, to send as soon as it is read
+func (g *sportsData) eachStat(ctx context.Context, table statTable, req *rpc.StatRequest, template proto.Message, send func(proto.Message) error) error {
+	q, err := grpcStatQuery(table, req)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if q.team, err = g.s.resolveTeam(q.team); err != nil {
+		return grpcError(err)
+	}
+	rows, err := g.s.Repo.Stats(ctx, q)
+	if err != nil {
+		return grpcError(err)
+	}
+	return eachRow(rows, table.model, template, send)
+}
+
+// eachRow scans every row of a result set into model, passes it to send converted to a new message of the same type
+// as template, and closes the result set
+func eachRow(rows Rows, model reflect.Type, template proto.Message, send func(proto.Message) error) error {
+	defer rows.Close()
+	columns := jsonFields(model)
+	for rows.Next() {
+		v := reflect.New(model)
+		if err := rows.StructScan(v.Interface()); err != nil {
+			return grpcError(errors.Wrap(err, "error scanning row"))
+		}
+		m := template.ProtoReflect().New()
+		protoRow(m, v.Elem(), columns)
+		if err := send(m.Interface()); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return grpcError(errors.Wrap(err, "error reading rows"))
+	}
+	return nil
+}
+
+// protoRows converts every element of a slice of model structs into a new message of the same type as template
+func protoRows(slice interface{}, template proto.Message, add func(proto.Message)) {
+	v := reflect.ValueOf(slice)
+	columns := jsonFields(v.Type().Elem())
+	for i := 0; i < v.Len(); i++ {
+		m := template.ProtoReflect().New()
+		protoRow(m, v.Index(i), columns)
+		add(m.Interface())
+	}
+}
+
+// ListTeams returns every team
+func (g *sportsData) ListTeams(ctx context.Context, req *rpc.ListTeamsRequest) (*rpc.ListTeamsResponse, error) {
+	teams, err := g.s.Repo.Teams(ctx)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	resp := &rpc.ListTeamsResponse{}
+	for _, t := range teams {
+		resp.Teams = append(resp.Teams, &rpc.Team{Id: t.ID, Teamname: t.TeamName, Teamabbrev: t.TeamAbbrev})
+	}
+	return resp, nil
+}
+
+// ListBatting returns the latest (or asof) batting rows of a team, or of every team
+func (g *sportsData) ListBatting(ctx context.Context, req *rpc.StatRequest) (*rpc.ListBattingResponse, error) {
+	resp := &rpc.ListBattingResponse{}
+	err := g.eachStat(ctx, statTables["batting"], req, &rpc.Batter{}, func(m proto.Message) error {
+		resp.Batters = append(resp.Batters, m.(*rpc.Batter))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// StreamBatting streams the rows of ListBatting
+func (g *sportsData) StreamBatting(req *rpc.StatRequest, stream grpc.ServerStreamingServer[rpc.Batter]) error {
+	return g.eachStat(stream.Context(), statTables["batting"], req, &rpc.Batter{}, func(m proto.Message) error {
+		return stream.Send(m.(*rpc.Batter))
+	})
+}
+
+// ListPitching returns the latest (or asof) pitching rows of a team, or of every team
+func (g *sportsData) ListPitching(ctx context.Context, req *rpc.StatRequest) (*rpc.ListPitchingResponse, error) {
+	resp := &rpc.ListPitchingResponse{}
+	err := g.eachStat(ctx, statTables["pitching"], req, &rpc.Pitcher{}, func(m proto.Message) error {
+		resp.Pitchers = append(resp.Pitchers, m.(*rpc.Pitcher))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// StreamPitching streams the rows of ListPitching
+func (g *sportsData) StreamPitching(req *rpc.StatRequest, stream grpc.ServerStreamingServer[rpc.Pitcher]) error {
+	return g.eachStat(stream.Context(), statTables["pitching"], req, &rpc.Pitcher{}, func(m proto.Message) error {
+		return stream.Send(m.(*rpc.Pitcher))
+	})
+}
+
+// ListBattingSplits returns the latest (or asof) batting split rows of a team, or of every team
+func (g *sportsData) ListBattingSplits(ctx context.Context, req *rpc.StatRequest) (*rpc.ListBattingSplitsResponse, error) {
+	resp := &rpc.ListBattingSplitsResponse{}
+	err := g.eachStat(ctx, statTables["batting_splits"], req, &rpc.BattingSplit{}, func(m proto.Message) error {
+		resp.Splits = append(resp.Splits, m.(*rpc.BattingSplit))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// StreamBattingSplits streams the rows of ListBattingSplits
+func (g *sportsData) StreamBattingSplits(req *rpc.StatRequest, stream grpc.ServerStreamingServer[rpc.BattingSplit]) error {
+	return g.eachStat(stream.Context(), statTables["batting_splits"], req, &rpc.BattingSplit{}, func(m proto.Message) error {
+		return stream.Send(m.(*rpc.BattingSplit))
+	})
+}
+
+// ListPitchingSplits returns the latest (or asof) pitching split rows of a team, or of every team
+func (g *sportsData) ListPitchingSplits(ctx context.Context, req *rpc.StatRequest) (*rpc.ListPitchingSplitsResponse, error) {
+	resp := &rpc.ListPitchingSplitsResponse{}
+	err := g.eachStat(ctx, statTables["pitching_splits"], req, &rpc.PitchingSplit{}, func(m proto.Message) error {
+		resp.Splits = append(resp.Splits, m.(*rpc.PitchingSplit))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// StreamPitchingSplits streams the rows of ListPitchingSplits
+func (g *sportsData) StreamPitchingSplits(req *rpc.StatRequest, stream grpc.ServerStreamingServer[rpc.PitchingSplit]) error {
+	return g.eachStat(stream.Context(), statTables["pitching_splits"], req, &rpc.PitchingSplit{}, func(m proto.Message) error {
+		return stream.Send(m.(*rpc.PitchingSplit))
+	})
+}
+
+// ListBaserunning returns the latest (or asof) baserunning rows of a team, or of every team
+func (g *sportsData) ListBaserunning(ctx context.Context, req *rpc.StatRequest) (*rpc.ListBaserunningResponse, error) {
+	resp := &rpc.ListBaserunningResponse{}
+	err := g.eachStat(ctx, statTables["baserunning"], req, &rpc.Baserunner{}, func(m proto.Message) error {
+		resp.Baserunners = append(resp.Baserunners, m.(*rpc.Baserunner))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// StreamBaserunning streams the rows of ListBaserunning
+func (g *sportsData) StreamBaserunning(req *rpc.StatRequest, stream grpc.ServerStreamingServer[rpc.Baserunner]) error {
+	return g.eachStat(stream.Context(), statTables["baserunning"], req, &rpc.Baserunner{}, func(m proto.Message) error {
+		return stream.Send(m.(*rpc.Baserunner))
+	})
+}
+
+// ListBattingPitching returns the latest (or asof) batting_pitching rows of a team, or of every team
+func (g *sportsData) ListBattingPitching(ctx context.Context, req *rpc.StatRequest) (*rpc.ListBattingPitchingResponse, error) {
+	resp := &rpc.ListBattingPitchingResponse{}
+	err := g.eachStat(ctx, statTables["batting_pitching"], req, &rpc.BattingPitching{}, func(m proto.Message) error {
+		resp.Rows = append(resp.Rows, m.(*rpc.BattingPitching))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// StreamBattingPitching streams the rows of ListBattingPitching
+func (g *sportsData) StreamBattingPitching(req *rpc.StatRequest, stream grpc.ServerStreamingServer[rpc.BattingPitching]) error {
+	return g.eachStat(stream.Context(), statTables["batting_pitching"], req, &rpc.BattingPitching{}, func(m proto.Message) error {
+		return stream.Send(m.(*rpc.BattingPitching))
+	})
+}
+
+// ListBattingHomeAway returns the latest (or asof) batting_home_away rows of a team, or of every team
+func (g *sportsData) ListBattingHomeAway(ctx context.Context, req *rpc.StatRequest) (*rpc.ListBattingSplitsResponse, error) {
+	resp := &rpc.ListBattingSplitsResponse{}
+	err := g.eachStat(ctx, statTables["batting_home_away"], req, &rpc.BattingSplit{}, func(m proto.Message) error {
+		resp.Splits = append(resp.Splits, m.(*rpc.BattingSplit))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// StreamBattingHomeAway streams the rows of ListBattingHomeAway
+func (g *sportsData) StreamBattingHomeAway(req *rpc.StatRequest, stream grpc.ServerStreamingServer[rpc.BattingSplit]) error {
+	return g.eachStat(stream.Context(), statTables["batting_home_away"], req, &rpc.BattingSplit{}, func(m proto.Message) error {
+		return stream.Send(m.(*rpc.BattingSplit))
+	})
+}
+
+// ListPitchingHomeAway returns the latest (or asof) pitching_home_away rows of a team, or of every team
+func (g *sportsData) ListPitchingHomeAway(ctx context.Context, req *rpc.StatRequest) (*rpc.ListPitchingSplitsResponse, error) {
+	resp := &rpc.ListPitchingSplitsResponse{}
+	err := g.eachStat(ctx, statTables["pitching_home_away"], req, &rpc.PitchingSplit{}, func(m proto.Message) error {
+		resp.Splits = append(resp.Splits, m.(*rpc.PitchingSplit))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// StreamPitchingHomeAway streams the rows of ListPitchingHomeAway
+func (g *sportsData) StreamPitchingHomeAway(req *rpc.StatRequest, stream grpc.ServerStreamingServer[rpc.PitchingSplit]) error {
+	return g.eachStat(stream.Context(), statTables["pitching_home_away"], req, &rpc.PitchingSplit{}, func(m proto.Message) error {
+		return stream.Send(m.(*rpc.PitchingSplit))
+	})
+}
+
+// ListSnapshots lists the createddate snapshots of a table, or of every snapshot table, per team
+func (g *sportsData) ListSnapshots(ctx context.Context, req *rpc.ListSnapshotsRequest) (*rpc.ListSnapshotsResponse, error) {
+	tables := snapshotTables
+	if table := req.GetTable(); table != "" {
+		if !isSnapshotTable(table) {
+			return nil, status.Error(codes.NotFound, "unknown table: "+table)
+		}
+		tables = []string{table}
+	}
+	team, err := g.s.resolveTeam(req.GetTeamabbrev())
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	snapshots, err := g.s.Repo.Snapshots(ctx, tables, team)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	resp := &rpc.ListSnapshotsResponse{}
+	protoRows(snapshots, &rpc.Snapshot{}, func(m proto.Message) {
+		resp.Snapshots = append(resp.Snapshots, m.(*rpc.Snapshot))
+	})
+	return resp, nil
+}
+
+// GetPlayerHistory returns every snapshot row of a player in the requested stat table ordered by createddate
+func (g *sportsData) GetPlayerHistory(ctx context.Context, req *rpc.PlayerHistoryRequest) (*rpc.PlayerHistoryResponse, error) {
+	stat := req.GetStat()
+	if stat == "" {
+		stat = "batting"
+	}
+	table, ok := playerTables[stat]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "unknown stat: "+stat)
+	}
+	snap, err := newRange(req.GetFrom(), req.GetTo())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	filters, err := grpcFilters(table.model, req.GetFilters())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	rows, err := g.s.Repo.History(ctx, table, table.selectList(nil), req.GetName(), snap, filters)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	resp := &rpc.PlayerHistoryResponse{}
+	switch stat {
+	case "batting":
+		err = eachRow(rows, table.model, &rpc.Batter{}, func(m proto.Message) error {
+			resp.Batters = append(resp.Batters, m.(*rpc.Batter))
+			return nil
+		})
+	case "pitching":
+		err = eachRow(rows, table.model, &rpc.Pitcher{}, func(m proto.Message) error {
+			resp.Pitchers = append(resp.Pitchers, m.(*rpc.Pitcher))
+			return nil
+		})
+	case "baserunning":
+		err = eachRow(rows, table.model, &rpc.Baserunner{}, func(m proto.Message) error {
+			resp.Baserunners = append(resp.Baserunners, m.(*rpc.Baserunner))
+			return nil
+		})
+	case "batting_pitching":
+		err = eachRow(rows, table.model, &rpc.BattingPitching{}, func(m proto.Message) error {
+			resp.BattingPitching = append(resp.BattingPitching, m.(*rpc.BattingPitching))
+			return nil
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// diffWindow validates a DiffRequest the same way GetDiff validates its parameters and returns the resolved team
+func (g *sportsData) diffWindow(ctx context.Context, table string, req *rpc.DiffRequest) (team string, from, to snapshot, err error) {
+	if req.GetTeamabbrev() == "" {
+		err = status.Error(codes.InvalidArgument, "teamabbrev is required")
+		return
+	}
+	if from, to, err = newDiffWindow(req.GetFrom(), req.GetTo()); err != nil {
+		err = status.Error(codes.InvalidArgument, err.Error())
+		return
+	}
+	if team, err = g.s.resolveTeam(req.GetTeamabbrev()); err != nil {
+		err = grpcError(err)
+		return
+	}
+	if err = g.s.checkWindow(ctx, table, team, from, to); err != nil {
+		err = grpcError(err)
+	}
+	return
+}
+
+// DiffBatting returns the batting deltas of a team between the snapshots current at from and to
+func (g *sportsData) DiffBatting(ctx context.Context, req *rpc.DiffRequest) (*rpc.DiffBattingResponse, error) {
+	team, from, to, err := g.diffWindow(ctx, "batting", req)
+	if err != nil {
+		return nil, err
+	}
+	start, end := []Batter{}, []Batter{}
+	err = g.s.selectWindow(ctx, &start, &end, statQuery{table: "batting", columns: battingColumns, team: team}, from, to)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	resp := &rpc.DiffBattingResponse{}
+	protoRows(diffBatting(start, end), &rpc.BattingDelta{}, func(m proto.Message) {
+		resp.Deltas = append(resp.Deltas, m.(*rpc.BattingDelta))
+	})
+	return resp, nil
+}
+
+// DiffPitching returns the pitching deltas of a team between the snapshots current at from and to
+func (g *sportsData) DiffPitching(ctx context.Context, req *rpc.DiffRequest) (*rpc.DiffPitchingResponse, error) {
+	team, from, to, err := g.diffWindow(ctx, "pitching", req)
+	if err != nil {
+		return nil, err
+	}
+	start, end := []Pitcher{}, []Pitcher{}
+	err = g.s.selectWindow(ctx, &start, &end, statQuery{table: "pitching", columns: pitchingColumns, team: team}, from, to)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	resp := &rpc.DiffPitchingResponse{}
+	protoRows(diffPitching(start, end), &rpc.PitchingDelta{}, func(m proto.Message) {
+		resp.Deltas = append(resp.Deltas, m.(*rpc.PitchingDelta))
+	})
+	return resp, nil
+}
+
+// DiffBaserunning returns the baserunning deltas of a team between the snapshots current at from and to
+func (g *sportsData) DiffBaserunning(ctx context.Context, req *rpc.DiffRequest) (*rpc.DiffBaserunningResponse, error) {
+	team, from, to, err := g.diffWindow(ctx, "baserunning", req)
+	if err != nil {
+		return nil, err
+	}
+	start, end := []Baserunner{}, []Baserunner{}
+	err = g.s.selectWindow(ctx, &start, &end, statQuery{table: "baserunning", columns: baserunningColumns, team: team}, from, to)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	resp := &rpc.DiffBaserunningResponse{}
+	protoRows(diffBaserunning(start, end), &rpc.BaserunnerDelta{}, func(m proto.Message) {
+		resp.Deltas = append(resp.Deltas, m.(*rpc.BaserunnerDelta))
+	})
+	return resp, nil
+}
+
+// leaders validates a LeadersRequest the same way GetLeaders validates its parameters and selects the ranked rows
+// of a leaderboard into a slice of its leader model
+func (g *sportsData) leaders(ctx context.Context, table string, req *rpc.LeadersRequest) (interface{}, error) {
+	lb := leaderboards[table]
+	var min, limit string
+	if req.GetMin() != 0 {
+		min = strconv.FormatFloat(req.GetMin(), 'f', -1, 64)
+	}
+	if req.GetLimit() != 0 {
+		limit = strconv.Itoa(int(req.GetLimit()))
+	}
+	params, err := lb.newLeaderParams(req.GetSort(), min, limit)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	snap, err := newSnapshot(req.GetAsof(), req.GetSnapshot())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	filters, err := grpcFilters(lb.table.model, req.GetFilters())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	rows, err := g.s.Repo.Leaders(ctx, lb, params, snap, filters)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	leaders, err := selectRows(rows, lb.leader)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return leaders, nil
+}
+
+// BattingLeaders ranks the latest (or asof) batting rows of every team
+func (g *sportsData) BattingLeaders(ctx context.Context, req *rpc.LeadersRequest) (*rpc.BattingLeadersResponse, error) {
+	leaders, err := g.leaders(ctx, "batting", req)
+	if err != nil {
+		return nil, err
+	}
+	resp := &rpc.BattingLeadersResponse{}
+	columns := jsonFields(reflect.TypeOf(Batter{}))
+	for _, l := range leaders.([]BattingLeader) {
+		b := &rpc.Batter{}
+		protoRow(b.ProtoReflect(), reflect.ValueOf(l.Batter), columns)
+		resp.Leaders = append(resp.Leaders, &rpc.BattingLeader{Leaguerank: int64(l.Leaguerank), Batter: b})
+	}
+	return resp, nil
+}
+
+// PitchingLeaders ranks the latest (or asof) pitching rows of every team
+func (g *sportsData) PitchingLeaders(ctx context.Context, req *rpc.LeadersRequest) (*rpc.PitchingLeadersResponse, error) {
+	leaders, err := g.leaders(ctx, "pitching", req)
+	if err != nil {
+		return nil, err
+	}
+	resp := &rpc.PitchingLeadersResponse{}
+	columns := jsonFields(reflect.TypeOf(Pitcher{}))
+	for _, l := range leaders.([]PitchingLeader) {
+		p := &rpc.Pitcher{}
+		protoRow(p.ProtoReflect(), reflect.ValueOf(l.Pitcher), columns)
+		resp.Leaders = append(resp.Leaders, &rpc.PitchingLeader{Leaguerank: int64(l.Leaguerank), Pitcher: p})
+	}
+	return resp, nil
+}
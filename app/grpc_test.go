@@ -0,0 +1,110 @@
+package app
+
+import (
+	"context"
+	"sports-data-api/rpc"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGRPCService(t *testing.T) {
+	ctx := context.Background()
+	g := &sportsData{s: &Server{Repo: fixtureRepository(t)}}
+
+	splits, err := g.ListBattingHomeAway(ctx, &rpc.StatRequest{Teamabbrev: "NYY"})
+	if err != nil || len(splits.GetSplits()) != 0 {
+		t.Errorf("ListBattingHomeAway: %v %v, want no rows", splits, err)
+	}
+	snapshots, err := g.ListSnapshots(ctx, &rpc.ListSnapshotsRequest{Table: "batting", Teamabbrev: "NYY"})
+	if err != nil || len(snapshots.GetSnapshots()) != 2 || snapshots.GetSnapshots()[0].GetRowcount() != 2 {
+		t.Errorf("ListSnapshots: %v %v, want two NYY batting snapshots of two rows", snapshots, err)
+	}
+	history, err := g.GetPlayerHistory(ctx, &rpc.PlayerHistoryRequest{Name: "Aaron Judge"})
+	if err != nil || len(history.GetBatters()) != 2 || len(history.GetPitchers()) != 0 {
+		t.Errorf("GetPlayerHistory: %v %v, want two batting rows", history, err)
+	}
+	diff, err := g.DiffBatting(ctx, &rpc.DiffRequest{Teamabbrev: "NYY", From: "2020-08-01", To: "2020-08-08"})
+	if err != nil || len(diff.GetDeltas()) != 2 {
+		t.Fatalf("DiffBatting: %v %v, want two deltas", diff, err)
+	}
+	for _, d := range diff.GetDeltas() {
+		if d.GetName().GetValue() == "Aaron Judge" && (d.GetG().GetValue() != 4 || d.GetBa().GetValue() != 0.231) {
+			t.Errorf("DiffBatting: Aaron Judge g %d ba %v, want 4 0.231", d.GetG().GetValue(), d.GetBa().GetValue())
+		}
+	}
+	leaders, err := g.BattingLeaders(ctx, &rpc.LeadersRequest{Sort: "-sf", Limit: 2})
+	if err != nil || len(leaders.GetLeaders()) != 4 || leaders.GetLeaders()[0].GetBatter().GetName() == nil {
+		t.Errorf("BattingLeaders: %v %v, want the 4 batters ranked within 2 on sf", leaders, err)
+	}
+	pitchers, err := g.PitchingLeaders(ctx, &rpc.LeadersRequest{Min: 30})
+	if err != nil || len(pitchers.GetLeaders()) != 1 || pitchers.GetLeaders()[0].GetLeaguerank() != 1 {
+		t.Errorf("PitchingLeaders: %v %v, want one qualified pitcher", pitchers, err)
+	}
+
+	for _, tt := range []struct {
+		name string
+		call func() error
+		code codes.Code
+	}{
+		{"unknown table", func() error {
+			_, err := g.ListSnapshots(ctx, &rpc.ListSnapshotsRequest{Table: "users"})
+			return err
+		}, codes.NotFound},
+		{"unknown team", func() error {
+			_, err := g.ListBattingPitching(ctx, &rpc.StatRequest{Teamabbrev: "XXX"})
+			return err
+		}, codes.NotFound},
+		{"unknown stat", func() error {
+			_, err := g.GetPlayerHistory(ctx, &rpc.PlayerHistoryRequest{Name: "Aaron Judge", Stat: "fielding"})
+			return err
+		}, codes.InvalidArgument},
+		{"diff without team", func() error {
+			_, err := g.DiffPitching(ctx, &rpc.DiffRequest{})
+			return err
+		}, codes.InvalidArgument},
+		{"diff without baseline", func() error {
+			_, err := g.DiffPitching(ctx, &rpc.DiffRequest{Teamabbrev: "NYY", From: "2020-08-01", To: "2020-08-08"})
+			return err
+		}, codes.NotFound},
+		{"diff within one snapshot", func() error {
+			_, err := g.DiffBaserunning(ctx, &rpc.DiffRequest{Teamabbrev: "NYY", From: "2020-08-02", To: "2020-08-03"})
+			return err
+		}, codes.NotFound},
+		{"leaders limit", func() error {
+			_, err := g.BattingLeaders(ctx, &rpc.LeadersRequest{Limit: maxLeaderLimit + 1})
+			return err
+		}, codes.InvalidArgument},
+		{"leaders sort", func() error {
+			_, err := g.PitchingLeaders(ctx, &rpc.LeadersRequest{Sort: "-war"})
+			return err
+		}, codes.InvalidArgument},
+	} {
+		if got := status.Code(tt.call()); got != tt.code {
+			t.Errorf("%s: code %v, want %v", tt.name, got, tt.code)
+		}
+	}
+}
+
+func TestGRPCInternalError(t *testing.T) {
+	ctx := context.Background()
+	repo := sqliteRepository(t)
+	repo.Db.Close()
+	g := &sportsData{s: &Server{Repo: repo}}
+	for name, call := range map[string]func() error{
+		"ListTeams": func() error {
+			_, err := g.ListTeams(ctx, &rpc.ListTeamsRequest{})
+			return err
+		},
+		"ListBatting": func() error {
+			_, err := g.ListBatting(ctx, &rpc.StatRequest{})
+			return err
+		},
+	} {
+		s := status.Convert(call())
+		if s.Code() != codes.Internal || s.Message() != "internal error" {
+			t.Errorf("%s: %v %q, want the database error withheld", name, s.Code(), s.Message())
+		}
+	}
+}
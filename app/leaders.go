@@ -0,0 +1,121 @@
+package app
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultLeaderLimit = 25
+	maxLeaderLimit     = 1000
+)
+
+// leaderboard describes how a stat table is ranked league-wide
+type leaderboard struct {
+	table       statTable
+	leader      reflect.Type // model the ranked rows are scanned into
+	defaultSort string       // sort parameter used when none is supplied
+	qualifier   string       // query parameter holding the qualification threshold
+	column      string       // column the qualification threshold applies to
+}
+
+// leaderboards are keyed by the table segment of /leaders/{table}
+var leaderboards = map[string]leaderboard{
+	"batting": {
+		table:       playerTables["batting"],
+		leader:      reflect.TypeOf(BattingLeader{}),
+		defaultSort: "-ops",
+		qualifier:   "min_pa",
+		column:      "pa",
+	},
+	"pitching": {
+		table:       playerTables["pitching"],
+		leader:      reflect.TypeOf(PitchingLeader{}),
+		defaultSort: "era",
+		qualifier:   "min_ip",
+		column:      "ip",
+	},
+}
+
+// leaderParams holds the validated sort, threshold and limit for a leaderboard request
+type leaderParams struct {
+	sort  string
+	desc  bool
+	min   float64
+	limit int
+}
+
+// parseLeaderParams reads sort (a field name, prefixed with - for descending), the board's qualification
+// threshold and limit
+func (lb leaderboard) parseLeaderParams(r *http.Request) (leaderParams, error) {
+	query := r.URL.Query()
+	return lb.newLeaderParams(query.Get("sort"), query.Get(lb.qualifier), query.Get("limit"))
+}
+
+// newLeaderParams validates a sort, qualification threshold and limit; empty values take the board's defaults
+func (lb leaderboard) newLeaderParams(sort, min, limit string) (p leaderParams, err error) {
+	if sort == "" {
+		sort = lb.defaultSort
+	}
+	if p.sort, p.desc, err = parseSort(lb.table.model, sort); err != nil {
+		return
+	}
+	if min != "" {
+		if p.min, err = strconv.ParseFloat(min, 64); err != nil {
+			err = errors.Errorf("invalid %s parameter: %s", lb.qualifier, min)
+			return
+		}
+	}
+	p.limit = defaultLeaderLimit
+	if limit != "" {
+		if p.limit, err = strconv.Atoi(limit); err != nil || p.limit < 1 || p.limit > maxLeaderLimit {
+			err = errors.Errorf("limit must be between 1 and %d", maxLeaderLimit)
+			return
+		}
+	}
+	return
+}
+
+// params are the reserved parameters a leaderboard reads
+func (lb leaderboard) params() []string {
+	return []string{"sort", lb.qualifier, "limit", "asof", "snapshot", "format"}
+}
+
+// parseSort validates a sort field against the json tags of model; a leading - sorts descending
+func parseSort(model reflect.Type, sort string) (column string, desc bool, err error) {
+	desc = strings.HasPrefix(sort, "-")
+	column = strings.ToLower(strings.TrimPrefix(strings.TrimPrefix(sort, "-"), "+"))
+	if _, ok := fieldIndex(model)[column]; !ok {
+		err = errors.Errorf("unknown sort field: %s", column)
+	}
+	return
+}
+
+// leaderQuery ranks the filtered latest snapshot rows of every team by the sort column; players tied on the sort
+// column share a rank and every player ranked within the limit is returned
+func (lb leaderboard) leaderQuery(d dialect, p leaderParams, snap snapshot, filters []filter) (string, []interface{}) {
+	latest, args := statQuery{table: lb.table.name, columns: lb.table.columns, snap: snap, filters: filters, derived: true}.build(d)
+	direction := "ASC"
+	if p.desc {
+		direction = "DESC"
+	}
+	args = append(args, p.min, p.limit)
+	query := fmt.Sprintf(
+		`SELECT	*
+		FROM	(
+					SELECT	l.*, RANK() OVER(ORDER BY l.%[1]s %[2]s) AS leaguerank
+					FROM	(%[3]s) l
+					WHERE	l.%[1]s IS NOT NULL
+					AND		COALESCE(l.%[4]s, 0) >= ?
+				) ranked
+		WHERE	leaguerank <= ?
+		ORDER BY leaguerank, name`,
+		p.sort, direction, latest, lb.column,
+	)
+	return query, args
+}
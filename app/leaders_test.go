@@ -0,0 +1,50 @@
+package app
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestGetLeaders(t *testing.T) {
+	tests := []struct {
+		path  string
+		ranks []int
+		names []string
+	}{
+		// Moreland, Judge and LeMahieu tie on sf behind Vazquez, so all four are ranked within a limit of 2
+		{"/api/v1/mlb/leaders/batting?sort=-sf&limit=2", []int{1, 2, 2, 2}, []string{"Christian Vazquez", "Aaron Judge", "DJ LeMahieu", "Mitch Moreland*"}},
+		{"/api/v1/mlb/leaders/batting?sort=-sf&limit=1", []int{1}, []string{"Christian Vazquez"}},
+		{"/api/v1/mlb/leaders/batting?sort=pa&min_pa=120", []int{1, 2}, []string{"Mitch Moreland*", "Christian Vazquez"}},
+		{"/api/v1/mlb/leaders/batting?sort=pa&min_pa=500", []int{}, []string{}},
+		{"/api/v1/mlb/leaders/pitching?min_ip=30", []int{1}, []string{"Gerrit Cole"}},
+		{"/api/v1/mlb/leaders/pitching?sort=-so&limit=1&asof=2020-08-02", []int{1}, []string{"Nathan Eovaldi"}},
+	}
+	for backend, ts := range testServers(t) {
+		for _, tt := range tests {
+			var leaders []BattingLeader
+			ts.get(tt.path, http.StatusOK, &leaders)
+			if len(leaders) != len(tt.names) {
+				t.Errorf("%s: GET %s: got %d leaders, want %d", backend, tt.path, len(leaders), len(tt.names))
+				continue
+			}
+			for i, l := range leaders {
+				if l.Leaguerank != tt.ranks[i] || l.Name.String != tt.names[i] {
+					t.Errorf("%s: GET %s: leader %d is %d %s, want %d %s", backend, tt.path, i, l.Leaguerank, l.Name.String, tt.ranks[i], tt.names[i])
+				}
+			}
+		}
+		for _, path := range []string{
+			"/api/v1/mlb/leaders/batting?sort=-war",
+			"/api/v1/mlb/leaders/batting?sort=name%20desc",
+			"/api/v1/mlb/leaders/batting?min_pa=lots",
+			"/api/v1/mlb/leaders/pitching?limit=0",
+			"/api/v1/mlb/leaders/batting?min_ip=30",
+			"/api/v1/mlb/leaders/batting?fields=name",
+			"/api/v1/mlb/leaders/pitching?limit=1001",
+		} {
+			if p := ts.problem(path, http.StatusBadRequest); p.Code != codeInvalidParameter {
+				t.Errorf("%s: GET %s: code %q, want %q", backend, path, p.Code, codeInvalidParameter)
+			}
+		}
+	}
+}
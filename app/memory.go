@@ -0,0 +1,539 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v3"
+)
+
+// FixtureUser is an API user read from the users fixture in place of basic_auth.user_role
+type FixtureUser struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Role     string `json:"role"`
+}
+
+// MemoryRepository answers the StatsRepository queries from rows held in memory, with the same snapshot ranking,
+// filtering, ordering and paging as the SQL; it is read-only once loaded
+type MemoryRepository struct {
+	teams  []Team
+	tables map[string][]reflect.Value // model structs per stat table
+	audit  []Audit
+	users  []FixtureUser
+}
+
+// LoadMemoryRepository reads team.json, one <table>.json per stat table, audit.json and users.json from dir; each
+// holds a JSON array in the shape the API returns, and a missing file is an empty table. Stat rows are joined to
+// their team by teamabbrev
+func LoadMemoryRepository(dir string) (*MemoryRepository, error) {
+	m := &MemoryRepository{tables: map[string][]reflect.Value{}}
+	read := func(name string, dest interface{}) error {
+		b, err := os.ReadFile(filepath.Join(dir, name+".json"))
+		if os.IsNotExist(err) {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "error reading %s fixture", name)
+		}
+		return errors.Wrapf(json.Unmarshal(b, dest), "error parsing %s fixture", name)
+	}
+	if err := read("team", &m.teams); err != nil {
+		return nil, err
+	}
+	sort.Slice(m.teams, func(i, j int) bool { return m.teams[i].TeamAbbrev < m.teams[j].TeamAbbrev })
+	teamids := make(map[string]int, len(m.teams))
+	for i, t := range m.teams {
+		id, err := strconv.Atoi(t.ID)
+		if err != nil {
+			id = i + 1
+		}
+		teamids[t.TeamAbbrev] = id
+	}
+	for _, name := range snapshotTables {
+		rows := reflect.New(reflect.SliceOf(statTables[name].model))
+		if err := read(name, rows.Interface()); err != nil {
+			return nil, err
+		}
+		for i := 0; i < rows.Elem().Len(); i++ {
+			row := rows.Elem().Index(i)
+			teamid, ok := teamids[row.FieldByName("Teamabbrev").String()]
+			if !ok {
+				return nil, errors.Errorf("%s fixture row %d has unknown team %q", name, i, row.FieldByName("Teamabbrev").String())
+			}
+			row.FieldByName("Teamid").SetInt(int64(teamid))
+			m.tables[name] = append(m.tables[name], row)
+		}
+	}
+	if err := read("audit", &m.audit); err != nil {
+		return nil, err
+	}
+	if err := read("users", &m.users); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// sliceRows iterates model structs as a result set
+type sliceRows struct {
+	rows []reflect.Value
+	i    int
+}
+
+func (s *sliceRows) Next() bool {
+	s.i++
+	return s.i <= len(s.rows)
+}
+
+func (s *sliceRows) StructScan(dest interface{}) error {
+	v := reflect.ValueOf(dest).Elem()
+	row := s.rows[s.i-1]
+	if v.Type() != row.Type() {
+		return errors.Errorf("cannot scan %s into %s", row.Type(), v.Type())
+	}
+	v.Set(row)
+	return nil
+}
+
+func (s *sliceRows) Err() error {
+	return nil
+}
+
+func (s *sliceRows) Close() error {
+	return nil
+}
+
+// scalar reads a model field as an int64, float64, string or time.Time; ok is false for null values
+func scalar(v reflect.Value) (value interface{}, ok bool) {
+	switch x := v.Interface().(type) {
+	case int:
+		return int64(x), true
+	case string:
+		return x, true
+	case null.Int:
+		return x.Int64, x.Valid
+	case null.Float:
+		return x.Float64, x.Valid
+	case null.String:
+		return x.String, x.Valid
+	case null.Time:
+		return x.Time, x.Valid
+	}
+	return nil, false
+}
+
+// compareScalars orders two values read by scalar, or a value with a filter value of the field's type
+func compareScalars(a, b interface{}) int {
+	switch x := a.(type) {
+	case int64:
+		switch y := b.(type) {
+		case int64:
+			return compareFloats(float64(x), float64(y))
+		case float64:
+			return compareFloats(float64(x), y)
+		}
+	case float64:
+		switch y := b.(type) {
+		case int64:
+			return compareFloats(x, float64(y))
+		case float64:
+			return compareFloats(x, y)
+		}
+	case string:
+		return strings.Compare(x, b.(string))
+	case time.Time:
+		y := b.(time.Time)
+		switch {
+		case x.Before(y):
+			return -1
+		case x.After(y):
+			return 1
+		}
+	}
+	return 0
+}
+
+func compareFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// likePattern compiles an ILIKE pattern, where % matches any run of characters and _ any single character
+func likePattern(pattern string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("(?is)^")
+	for _, c := range pattern {
+		switch c {
+		case '%':
+			b.WriteString(".*")
+		case '_':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString("$")
+	return regexp.MustCompile(b.String())
+}
+
+// match applies a filter to a model struct the way the SQL comparison would; null fields never match
+func (f filter) match(v reflect.Value) bool {
+	value, ok := scalar(v.FieldByIndex(fieldIndex(v.Type())[f.column]))
+	if !ok {
+		return false
+	}
+	switch f.op {
+	case "like":
+		return likePattern(f.values[0].(string)).MatchString(value.(string))
+	case "in":
+		for _, want := range f.values {
+			if compareScalars(value, want) == 0 {
+				return true
+			}
+		}
+		return false
+	}
+	cmp := compareScalars(value, f.values[0])
+	switch f.op {
+	case "eq":
+		return cmp == 0
+	case "ne":
+		return cmp != 0
+	case "gt":
+		return cmp > 0
+	case "gte":
+		return cmp >= 0
+	case "lt":
+		return cmp < 0
+	case "lte":
+		return cmp <= 0
+	}
+	return false
+}
+
+// createddate reads the snapshot timestamp of a model struct
+func createddate(v reflect.Value) null.Time {
+	return v.FieldByName("Createddate").Interface().(null.Time)
+}
+
+// within reports whether a createddate falls inside the bounds of snap
+func (snap snapshot) within(t null.Time) bool {
+	if !t.Valid {
+		return snap.from.IsZero() && snap.to.IsZero()
+	}
+	return (snap.from.IsZero() || !t.Time.Before(snap.from)) && (snap.to.IsZero() || !t.Time.After(snap.to))
+}
+
+// rowKey orders model structs by (teamid, id), the page key
+func rowKey(v reflect.Value) (int64, int64) {
+	return v.FieldByName("Teamid").Int(), v.FieldByName("ID").Int()
+}
+
+func compareKeys(a, b reflect.Value) int {
+	teamid, id := rowKey(b)
+	return compareKey(a, teamid, id)
+}
+
+// compareKey orders a model struct against a (teamid, id) page key
+func compareKey(v reflect.Value, teamid, id int64) int {
+	t, i := rowKey(v)
+	if t != teamid {
+		return compareFloats(float64(t), float64(teamid))
+	}
+	return compareFloats(float64(i), float64(id))
+}
+
+// matching returns the rows of a stat table for team (every team when empty) inside snap that pass filters
+func (m *MemoryRepository) matching(table, team string, snap snapshot, filters []filter) []reflect.Value {
+	rows := []reflect.Value{}
+	for _, row := range m.tables[table] {
+		if team != "" && row.FieldByName("Teamabbrev").String() != team {
+			continue
+		}
+		if !snap.within(createddate(row)) {
+			continue
+		}
+		matched := true
+		for _, f := range filters {
+			if !f.match(row) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+// latest returns the filtered rows of the newest snapshot per team within q.snap, ordered by (teamid, id)
+func (m *MemoryRepository) latest(q statQuery) []reflect.Value {
+	newest := map[int64]time.Time{}
+	for _, row := range m.matching(q.table, q.team, q.snap, nil) {
+		teamid, _ := rowKey(row)
+		if t := createddate(row); t.Valid && t.Time.After(newest[teamid]) {
+			newest[teamid] = t.Time
+		}
+	}
+	rows := []reflect.Value{}
+	for _, row := range m.matching(q.table, q.team, q.snap, q.filters) {
+		teamid, _ := rowKey(row)
+		if t := createddate(row); t.Valid && t.Time.Equal(newest[teamid]) {
+			rows = append(rows, row)
+		}
+	}
+	sort.SliceStable(rows, func(i, j int) bool { return compareKeys(rows[i], rows[j]) < 0 })
+	return rows
+}
+
+// sortRows orders rows by a column, nulls last, then by (teamid, id)
+func sortRows(rows []reflect.Value, column string, desc bool) {
+	sort.SliceStable(rows, func(i, j int) bool {
+		index := fieldIndex(rows[i].Type())[column]
+		a, aok := scalar(rows[i].FieldByIndex(index))
+		b, bok := scalar(rows[j].FieldByIndex(index))
+		if aok != bok {
+			return aok
+		}
+		if aok {
+			if cmp := compareScalars(a, b); cmp != 0 {
+				return (cmp < 0) != desc
+			}
+		}
+		return compareKeys(rows[i], rows[j]) < 0
+	})
+}
+
+// Teams returns the team fixture
+func (m *MemoryRepository) Teams(ctx context.Context) ([]Team, error) {
+	return append([]Team{}, m.teams...), nil
+}
+
+// UserRole looks the credentials up in the users fixture
+func (m *MemoryRepository) UserRole(ctx context.Context, username, password string) (string, error) {
+	for _, u := range m.users {
+		if u.Username == username && u.Password == password {
+			return u.Role, nil
+		}
+	}
+	return "", nil
+}
+
+// Stats returns the latest snapshot rows selected by q
+func (m *MemoryRepository) Stats(ctx context.Context, q statQuery) (Rows, error) {
+	if _, ok := statTables[q.table]; !ok {
+		return nil, errors.Errorf("unknown table: %s", q.table)
+	}
+	rows := m.latest(q)
+	switch {
+	case q.page != nil:
+		if c := q.page.cursor; c != nil {
+			after := []reflect.Value{}
+			for _, row := range rows {
+				if cmp := compareKey(row, int64(c.Teamid), int64(c.ID)); (cmp > 0 && !c.Prev) || (cmp < 0 && c.Prev) {
+					after = append(after, row)
+				}
+			}
+			rows = after
+			if c.Prev {
+				for i, j := 0, len(rows)-1; i < j; i, j = i+1, j-1 {
+					rows[i], rows[j] = rows[j], rows[i]
+				}
+			}
+		}
+		if len(rows) > q.page.limit+1 {
+			rows = rows[:q.page.limit+1]
+		}
+	case q.sort != "" || q.limit > 0:
+		if q.sort != "" {
+			sortRows(rows, q.sort, q.desc)
+		}
+		if q.limit > 0 && len(rows) > q.limit {
+			rows = rows[:q.limit]
+		}
+	}
+	return &sliceRows{rows: rows}, nil
+}
+
+// History returns every snapshot row of a player, matched without handedness markers, ordered by createddate
+func (m *MemoryRepository) History(ctx context.Context, table statTable, columns, name string, snap snapshot, filters []filter) (Rows, error) {
+	rows := []reflect.Value{}
+	for _, row := range m.matching(table.name, "", snap, filters) {
+		if player := row.FieldByName("Name").Interface().(null.String); player.Valid && playerKey(player.String) == name {
+			rows = append(rows, row)
+		}
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		if cmp := compareScalars(createddate(rows[i]).Time, createddate(rows[j]).Time); cmp != 0 {
+			return cmp < 0
+		}
+		return rows[i].FieldByName("ID").Int() < rows[j].FieldByName("ID").Int()
+	})
+	return &sliceRows{rows: rows}, nil
+}
+
+// Range returns every snapshot row of a stat table inside snap ordered by createddate, teamid and id
+func (m *MemoryRepository) Range(ctx context.Context, table statTable, team string, snap snapshot) (Rows, error) {
+	rows := m.matching(table.name, team, snap, nil)
+	sort.SliceStable(rows, func(i, j int) bool {
+		if cmp := compareScalars(createddate(rows[i]).Time, createddate(rows[j]).Time); cmp != 0 {
+			return cmp < 0
+		}
+		return compareKeys(rows[i], rows[j]) < 0
+	})
+	return &sliceRows{rows: rows}, nil
+}
+
+// Snapshots counts the rows of each createddate per table and team
+func (m *MemoryRepository) Snapshots(ctx context.Context, tables []string, team string) ([]Snapshot, error) {
+	snapshots := []Snapshot{}
+	for _, table := range tables {
+		counts := map[Snapshot]int{}
+		for _, row := range m.matching(table, team, snapshot{}, nil) {
+			counts[Snapshot{Tablename: table, Teamabbrev: row.FieldByName("Teamabbrev").String(), Createddate: createddate(row)}]++
+		}
+		for s, n := range counts {
+			s.Rowcount = n
+			snapshots = append(snapshots, s)
+		}
+	}
+	sort.Slice(snapshots, func(i, j int) bool {
+		a, b := snapshots[i], snapshots[j]
+		if a.Tablename != b.Tablename {
+			return a.Tablename < b.Tablename
+		}
+		if a.Teamabbrev != b.Teamabbrev {
+			return a.Teamabbrev < b.Teamabbrev
+		}
+		return a.Createddate.Time.After(b.Createddate.Time)
+	})
+	return snapshots, nil
+}
+
+// LastModified returns the newest createddate of a stat table inside snap
+func (m *MemoryRepository) LastModified(ctx context.Context, table, team string, snap snapshot) (modified null.Time, err error) {
+	for _, row := range m.matching(table, team, snap, nil) {
+		if t := createddate(row); t.Valid && (!modified.Valid || t.Time.After(modified.Time)) {
+			modified = t
+		}
+	}
+	return
+}
+
+// Leaders ranks the qualified latest snapshot rows of every team by the sort column; tied players share a rank
+func (m *MemoryRepository) Leaders(ctx context.Context, lb leaderboard, p leaderParams, snap snapshot, filters []filter) (Rows, error) {
+	index := fieldIndex(lb.table.model)
+	qualified := []reflect.Value{}
+	for _, row := range m.latest(statQuery{table: lb.table.name, snap: snap, filters: filters}) {
+		if _, ok := scalar(row.FieldByIndex(index[p.sort])); !ok {
+			continue
+		}
+		threshold, ok := scalar(row.FieldByIndex(index[lb.column]))
+		if !ok {
+			threshold = int64(0)
+		}
+		if compareScalars(threshold, p.min) >= 0 {
+			qualified = append(qualified, row)
+		}
+	}
+	sortRows(qualified, p.sort, p.desc)
+	leaders := []reflect.Value{}
+	rank := 0
+	for i, row := range qualified {
+		if i == 0 || compareScalars(mustScalar(qualified[i-1].FieldByIndex(index[p.sort])), mustScalar(row.FieldByIndex(index[p.sort]))) != 0 {
+			rank = i + 1
+		}
+		if rank > p.limit {
+			break
+		}
+		leader := reflect.New(lb.leader).Elem()
+		leader.Field(0).SetInt(int64(rank))
+		leader.Field(1).Set(row)
+		leaders = append(leaders, leader)
+	}
+	sort.SliceStable(leaders, func(i, j int) bool {
+		if ri, rj := leaders[i].Field(0).Int(), leaders[j].Field(0).Int(); ri != rj {
+			return ri < rj
+		}
+		return leaders[i].Field(1).FieldByName("Name").Interface().(null.String).String < leaders[j].Field(1).FieldByName("Name").Interface().(null.String).String
+	})
+	return &sliceRows{rows: leaders}, nil
+}
+
+func mustScalar(v reflect.Value) interface{} {
+	value, _ := scalar(v)
+	return value
+}
+
+// Audit returns the audit rows matching f, newest first
+func (m *MemoryRepository) Audit(ctx context.Context, f auditFilter) ([]Audit, error) {
+	audits := []Audit{}
+	for _, a := range m.audit {
+		if (f.team != "" && a.Teamabbrev.String != f.team) || (f.table != "" && a.Tablename.String != f.table) {
+			continue
+		}
+		if (f.status.Valid && int64(a.Statusid) != f.status.Int64) || !f.snap.within(a.Createddate) {
+			continue
+		}
+		audits = append(audits, a)
+	}
+	sort.SliceStable(audits, func(i, j int) bool {
+		if !audits[i].Createddate.Time.Equal(audits[j].Createddate.Time) {
+			return audits[i].Createddate.Time.After(audits[j].Createddate.Time)
+		}
+		return audits[i].ID > audits[j].ID
+	})
+	if len(audits) > f.limit {
+		audits = audits[:f.limit]
+	}
+	return audits, nil
+}
+
+// Freshness returns the latest successful and failed audit row per table per team
+func (m *MemoryRepository) Freshness(ctx context.Context, team string) ([]Freshness, error) {
+	latest := map[[2]string]*Freshness{}
+	for _, a := range m.audit {
+		if !a.Teamabbrev.Valid || !a.Tablename.Valid || (team != "" && a.Teamabbrev.String != team) || !a.Createddate.Valid {
+			continue
+		}
+		key := [2]string{a.Teamabbrev.String, a.Tablename.String}
+		f, ok := latest[key]
+		if !ok {
+			f = &Freshness{Teamabbrev: key[0], Tablename: key[1]}
+			latest[key] = f
+		}
+		last := &f.Lastfailure
+		if a.Statusid == 0 {
+			last = &f.Lastsuccess
+		}
+		if !last.Valid || a.Createddate.Time.After(last.Time) {
+			*last = a.Createddate
+		}
+	}
+	freshness := make([]Freshness, 0, len(latest))
+	for _, f := range latest {
+		freshness = append(freshness, *f)
+	}
+	sort.Slice(freshness, func(i, j int) bool {
+		if freshness[i].Teamabbrev != freshness[j].Teamabbrev {
+			return freshness[i].Teamabbrev < freshness[j].Teamabbrev
+		}
+		return freshness[i].Tablename < freshness[j].Tablename
+	})
+	return freshness, nil
+}
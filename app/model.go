@@ -12,6 +12,7 @@ type Team struct {
 // Pitcher represents data for a single pitcher
 type Pitcher struct {
 	ID          int         `json:"id"`
+	Teamid      int         `json:"-"` // page key; only selected when paginating
 	Teamabbrev  string      `json:"teamabbrev"`
 	Rk          int         `json:"rk"`
 	Pos         null.String `json:"pos"`
@@ -53,6 +54,7 @@ type Pitcher struct {
 // Batter represents data for a single batter
 type Batter struct {
 	ID          int         `json:"id"`
+	Teamid      int         `json:"-"` // page key; only selected when paginating
 	Teamabbrev  string      `json:"teamabbrev"`
 	Rk          int         `json:"rk"`
 	Pos         null.String `json:"pos"`
@@ -88,6 +90,7 @@ type Batter struct {
 // BattingSplit represents data for a batting_splits
 type BattingSplit struct {
 	ID          int         `json:"id"`
+	Teamid      int         `json:"-"` // page key; only selected when paginating
 	Teamabbrev  string      `json:"teamabbrev"`
 	Split       null.String `json:"split"`
 	G           null.Int    `json:"g"`
@@ -124,6 +127,7 @@ type BattingSplit struct {
 // PitchingSplit represents data for a pitching splits
 type PitchingSplit struct {
 	ID          int         `json:"id"`
+	Teamid      int         `json:"-"` // page key; only selected when paginating
 	Teamabbrev  string      `json:"teamabbrev"`
 	Split       null.String `json:"split"`
 	G           null.Int    `json:"g"`
@@ -159,6 +163,7 @@ type PitchingSplit struct {
 // Baserunner represents data for a baserunning
 type Baserunner struct {
 	ID          int         `json:"id"`
+	Teamid      int         `json:"-"` // page key; only selected when paginating
 	Teamabbrev  string      `json:"teamabbrev"`
 	Name        null.String `json:"name"`
 	Age         null.Int    `json:"age"`
@@ -196,3 +201,199 @@ type Baserunner struct {
 	Secondsh    null.Int    `json:"secondsh"`
 	Createddate null.Time   `json:"createddate"`
 }
+
+// Page holds the links to the pages neighbouring a paginated response; JSON responses are wrapped in an envelope
+// of {"data": [...], "next": ..., "prev": ...}
+type Page struct {
+	Next null.String `json:"next"`
+	Prev null.String `json:"prev"`
+}
+
+// Snapshot represents a single scrape of a stat table for a team
+type Snapshot struct {
+	Tablename   string    `json:"tablename"`
+	Teamabbrev  string    `json:"teamabbrev"`
+	Createddate null.Time `json:"createddate"`
+	Rowcount    int       `json:"rowcount"`
+}
+
+// BattingDelta represents the change in a batter's counting stats between two snapshots with rate stats
+// recomputed over just that window
+type BattingDelta struct {
+	Name       null.String `json:"name"`
+	Teamabbrev string      `json:"teamabbrev"`
+	Fromdate   null.Time   `json:"fromdate"`
+	Todate     null.Time   `json:"todate"`
+	G          null.Int    `json:"g"`
+	Pa         null.Int    `json:"pa"`
+	Ab         null.Int    `json:"ab"`
+	R          null.Int    `json:"r"`
+	H          null.Int    `json:"h"`
+	Twob       null.Int    `json:"twob"`
+	Threeb     null.Int    `json:"threeb"`
+	Hr         null.Int    `json:"hr"`
+	Rbi        null.Int    `json:"rbi"`
+	Sb         null.Int    `json:"sb"`
+	Cs         null.Int    `json:"cs"`
+	Bb         null.Int    `json:"bb"`
+	So         null.Int    `json:"so"`
+	Tb         null.Int    `json:"tb"`
+	Gdp        null.Int    `json:"gdp"`
+	Hbp        null.Int    `json:"hbp"`
+	Sh         null.Int    `json:"sh"`
+	Sf         null.Int    `json:"sf"`
+	Ibb        null.Int    `json:"ibb"`
+	Ba         null.Float  `json:"ba"`
+	Obp        null.Float  `json:"obp"`
+	Slg        null.Float  `json:"slg"`
+	Ops        null.Float  `json:"ops"`
+}
+
+// PitchingDelta represents the change in a pitcher's counting stats between two snapshots with rate stats
+// recomputed over just that window
+type PitchingDelta struct {
+	Name       null.String `json:"name"`
+	Teamabbrev string      `json:"teamabbrev"`
+	Fromdate   null.Time   `json:"fromdate"`
+	Todate     null.Time   `json:"todate"`
+	W          null.Int    `json:"w"`
+	L          null.Int    `json:"l"`
+	G          null.Int    `json:"g"`
+	Gs         null.Int    `json:"gs"`
+	Gf         null.Int    `json:"gf"`
+	Cg         null.Int    `json:"cg"`
+	Sho        null.Int    `json:"sho"`
+	Sv         null.Int    `json:"sv"`
+	IP         null.Float  `json:"ip"`
+	H          null.Int    `json:"h"`
+	R          null.Int    `json:"r"`
+	Er         null.Int    `json:"er"`
+	Hr         null.Int    `json:"hr"`
+	Bb         null.Int    `json:"bb"`
+	Ibb        null.Int    `json:"ibb"`
+	So         null.Int    `json:"so"`
+	Hbp        null.Int    `json:"hbp"`
+	Bk         null.Int    `json:"bk"`
+	Wp         null.Int    `json:"wp"`
+	Bf         null.Int    `json:"bf"`
+	Wl         null.Float  `json:"wl"`
+	Era        null.Float  `json:"era"`
+	Whip       null.Float  `json:"whip"`
+	H9         null.Float  `json:"h9"`
+	Hr9        null.Float  `json:"hr9"`
+	Bb9        null.Float  `json:"bb9"`
+	So9        null.Float  `json:"so9"`
+	Sow        null.Float  `json:"sow"`
+}
+
+// BaserunnerDelta represents the change in a baserunner's counting stats between two snapshots with
+// stolen base percentage recomputed over just that window
+type BaserunnerDelta struct {
+	Name       null.String `json:"name"`
+	Teamabbrev string      `json:"teamabbrev"`
+	Fromdate   null.Time   `json:"fromdate"`
+	Todate     null.Time   `json:"todate"`
+	Pa         null.Int    `json:"pa"`
+	Roe        null.Int    `json:"roe"`
+	Xi         null.Int    `json:"xi"`
+	Sbo        null.Int    `json:"sbo"`
+	Sb         null.Int    `json:"sb"`
+	Cs         null.Int    `json:"cs"`
+	Sb2        null.Int    `json:"sb2"`
+	Cs2        null.Int    `json:"cs2"`
+	Sb3        null.Int    `json:"sb3"`
+	Cs3        null.Int    `json:"cs3"`
+	Sbh        null.Int    `json:"sbh"`
+	Csh        null.Int    `json:"csh"`
+	Po         null.Int    `json:"po"`
+	Pcs        null.Int    `json:"pcs"`
+	Oob        null.Int    `json:"oob"`
+	Oob1       null.Int    `json:"oob1"`
+	Oob2       null.Int    `json:"oob2"`
+	Oob3       null.Int    `json:"oob3"`
+	Oobhm      null.Int    `json:"oobhm"`
+	Bt         null.Int    `json:"bt"`
+	Firsts     null.Int    `json:"firsts"`
+	Firsts2    null.Int    `json:"firsts2"`
+	Firsts3    null.Int    `json:"firsts3"`
+	Firstd     null.Int    `json:"firstd"`
+	Firstd3    null.Int    `json:"firstd3"`
+	Firstdh    null.Int    `json:"firstdh"`
+	Seconds    null.Int    `json:"seconds"`
+	Seconds3   null.Int    `json:"seconds3"`
+	Secondsh   null.Int    `json:"secondsh"`
+	Sbpct      null.Float  `json:"sbpct"`
+}
+
+// BattingLeader represents a batter ranked across the league
+type BattingLeader struct {
+	Leaguerank int `json:"leaguerank"`
+	Batter
+}
+
+// PitchingLeader represents a pitcher ranked across the league
+type PitchingLeader struct {
+	Leaguerank int `json:"leaguerank"`
+	Pitcher
+}
+
+// BattingPitching represents opponent batting against a single pitcher
+type BattingPitching struct {
+	ID          int         `json:"id"`
+	Teamid      int         `json:"-"` // page key; only selected when paginating
+	Teamabbrev  string      `json:"teamabbrev"`
+	Rk          int         `json:"rk"`
+	Name        null.String `json:"name"`
+	Age         null.Int    `json:"age"`
+	IP          null.Float  `json:"ip"`
+	G           null.Int    `json:"g"`
+	Pa          null.Int    `json:"pa"`
+	Ab          null.Int    `json:"ab"`
+	R           null.Int    `json:"r"`
+	H           null.Int    `json:"h"`
+	Twob        null.Int    `json:"twob"`
+	Threeb      null.Int    `json:"threeb"`
+	Hr          null.Int    `json:"hr"`
+	Sb          null.Int    `json:"sb"`
+	Cs          null.Int    `json:"cs"`
+	Bb          null.Int    `json:"bb"`
+	So          null.Int    `json:"so"`
+	Ba          null.Float  `json:"ba"`
+	Obp         null.Float  `json:"obp"`
+	Slg         null.Float  `json:"slg"`
+	Ops         null.Float  `json:"ops"`
+	Tb          null.Int    `json:"tb"`
+	Gdp         null.Int    `json:"gdp"`
+	Hbp         null.Int    `json:"hbp"`
+	Sh          null.Int    `json:"sh"`
+	Sf          null.Int    `json:"sf"`
+	Ibb         null.Int    `json:"ibb"`
+	Roe         null.Int    `json:"roe"`
+	Babip       null.Float  `json:"babip"`
+	Createddate null.Time   `json:"createddate"`
+}
+
+// BattingHomeAway represents data for a batting_home_away split; the scraped table shares the batting_splits columns
+type BattingHomeAway BattingSplit
+
+// PitchingHomeAway represents data for a pitching_home_away split; the scraped table shares the pitching_splits columns
+type PitchingHomeAway PitchingSplit
+
+// Audit represents a single scraper run entry from baseballreference.audit
+type Audit struct {
+	ID          int         `json:"id"`
+	Statusid    int         `json:"statusid"`
+	Status      string      `json:"status"`
+	Teamabbrev  null.String `json:"teamabbrev"`
+	Tablename   null.String `json:"tablename"`
+	Error       null.String `json:"error"`
+	Createddate null.Time   `json:"createddate"`
+}
+
+// Freshness represents the latest successful and failed scrape of a table for a team
+type Freshness struct {
+	Teamabbrev  string    `json:"teamabbrev"`
+	Tablename   string    `json:"tablename"`
+	Lastsuccess null.Time `json:"lastsuccess"`
+	Lastfailure null.Time `json:"lastfailure"`
+}
@@ -0,0 +1,557 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/go-chi/chi"
+	"github.com/pkg/errors"
+)
+
+// openAPI is the subset of an OpenAPI 3.0 document the API is described with
+type openAPI struct {
+	OpenAPI    string                              `json:"openapi"`
+	Info       apiInfo                             `json:"info"`
+	Paths      map[string]map[string]*apiOperation `json:"paths"`
+	Components apiComponents                       `json:"components"`
+}
+
+type apiInfo struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+type apiComponents struct {
+	Schemas         map[string]*apiSchema        `json:"schemas"`
+	SecuritySchemes map[string]apiSecurityScheme `json:"securitySchemes"`
+}
+
+type apiSecurityScheme struct {
+	Type         string `json:"type"`
+	Scheme       string `json:"scheme,omitempty"`
+	BearerFormat string `json:"bearerFormat,omitempty"`
+	In           string `json:"in,omitempty"`
+	Name         string `json:"name,omitempty"`
+}
+
+type apiOperation struct {
+	Summary     string                 `json:"summary"`
+	Description string                 `json:"description,omitempty"`
+	OperationID string                 `json:"operationId"`
+	Tags        []string               `json:"tags,omitempty"`
+	Parameters  []apiParameter         `json:"parameters,omitempty"`
+	RequestBody *apiRequestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]apiResponse `json:"responses"`
+	Security    []map[string][]string  `json:"security,omitempty"`
+}
+
+type apiParameter struct {
+	Name        string     `json:"name"`
+	In          string     `json:"in"`
+	Description string     `json:"description,omitempty"`
+	Required    bool       `json:"required,omitempty"`
+	Style       string     `json:"style,omitempty"`
+	Explode     bool       `json:"explode,omitempty"`
+	Schema      *apiSchema `json:"schema"`
+}
+
+type apiRequestBody struct {
+	Required bool                    `json:"required"`
+	Content  map[string]apiMediaType `json:"content"`
+}
+
+type apiResponse struct {
+	Description string                  `json:"description"`
+	Headers     map[string]apiHeader    `json:"headers,omitempty"`
+	Content     map[string]apiMediaType `json:"content,omitempty"`
+}
+
+type apiHeader struct {
+	Description string     `json:"description"`
+	Schema      *apiSchema `json:"schema"`
+}
+
+type apiMediaType struct {
+	Schema *apiSchema `json:"schema"`
+}
+
+type apiSchema struct {
+	Ref                  string                `json:"$ref,omitempty"`
+	Type                 string                `json:"type,omitempty"`
+	Format               string                `json:"format,omitempty"`
+	Nullable             bool                  `json:"nullable,omitempty"`
+	Enum                 []string              `json:"enum,omitempty"`
+	Items                *apiSchema            `json:"items,omitempty"`
+	OneOf                []*apiSchema          `json:"oneOf,omitempty"`
+	Properties           map[string]*apiSchema `json:"properties,omitempty"`
+	Required             []string              `json:"required,omitempty"`
+	AdditionalProperties *apiSchema            `json:"additionalProperties,omitempty"`
+}
+
+// routeDoc describes a registered route; model is the row type returned as an array in every negotiated format, or
+// models the row types one of which is returned, depending on a parameter
+type routeDoc struct {
+	summary     string
+	description string
+	tag         string
+	model       reflect.Type
+	models      []reflect.Type
+	params      []apiParameter
+	body        reflect.Type   // JSON request body
+	response    *apiResponse   // response other than rows of model
+	schemas     []reflect.Type // component schemas referenced by body or response
+}
+
+func queryParam(name, description string, schema *apiSchema) apiParameter {
+	return apiParameter{Name: name, In: "query", Description: description, Schema: schema}
+}
+
+func stringSchema(enum ...string) *apiSchema {
+	return &apiSchema{Type: "string", Enum: enum}
+}
+
+func refSchema(model reflect.Type) *apiSchema {
+	return &apiSchema{Ref: "#/components/schemas/" + model.Name()}
+}
+
+var (
+	formatParam   = queryParam("format", "response format; takes precedence over the Accept header", stringSchema(formatJSON, formatCSV, formatTSV, formatNDJSON))
+	fieldsParam   = queryParam("fields", "comma separated list of fields to return", stringSchema())
+	asofParam     = queryParam("asof", "latest snapshot at or before this date (YYYY-MM-DD) or RFC 3339 instant", stringSchema())
+	snapshotParam = queryParam("snapshot", "exact snapshot createddate; cannot be combined with asof", stringSchema())
+	fromParam     = queryParam("from", "start of the createddate range, a date (YYYY-MM-DD) or RFC 3339 instant", stringSchema())
+	toParam       = queryParam("to", "end of the createddate range, a date (YYYY-MM-DD) or RFC 3339 instant", stringSchema())
+	limitParam    = queryParam("limit", fmt.Sprintf("page size (1-%d, default %d); paginated JSON is wrapped in {data, next, prev} and links are sent in the Link header", maxPageLimit, defaultPageLimit), &apiSchema{Type: "integer"})
+	cursorParam   = queryParam("cursor", "opaque cursor from the next or prev link of a previous page", stringSchema())
+	filtersParam  = apiParameter{
+		Name:        "filters",
+		In:          "query",
+		Description: "any field as field=value or field[op]=value where op is one of eq, ne, gt, gte, lt, lte, like or in (comma separated values)",
+		Style:       "form",
+		Explode:     true,
+		Schema:      &apiSchema{Type: "object", AdditionalProperties: stringSchema()},
+	}
+	statParams = []apiParameter{formatParam, fieldsParam, asofParam, snapshotParam, limitParam, cursorParam, filtersParam}
+)
+
+// pathParams describes the URL parameters used across routes
+var pathParams = map[string]apiParameter{
+	"teamabbrev": {Description: "team abbreviation, e.g. NYY; case-insensitive and historic franchise codes such as MON are accepted", Schema: stringSchema()},
+	"name":       {Description: "player name without the handedness markers (* and #)", Schema: stringSchema()},
+	"table":      {Description: "stat table", Schema: stringSchema(sortedTables()...)},
+}
+
+// playerModels are the row types of the player tables, in stat order
+func playerModels() []reflect.Type {
+	stats := make([]string, 0, len(playerTables))
+	for stat := range playerTables {
+		stats = append(stats, stat)
+	}
+	sort.Strings(stats)
+	models := make([]reflect.Type, len(stats))
+	for i, stat := range stats {
+		models[i] = playerTables[stat].model
+	}
+	return models
+}
+
+func sortedTables() []string {
+	tables := make([]string, 0, len(statTables))
+	for table := range statTables {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	return tables
+}
+
+// routeDocs are keyed by method and route pattern; a route without an entry falls back to the entry for its pattern
+// with trailing path parameters removed
+var routeDocs = map[string]routeDoc{
+	"POST /account/generateToken": {
+		summary: "Generate a bearer token", tag: "account",
+		body: reflect.TypeOf(User{}),
+		response: &apiResponse{Description: "token valid for 72 hours", Content: map[string]apiMediaType{
+			"application/json": {Schema: &apiSchema{Type: "object", Properties: map[string]*apiSchema{"Token": stringSchema()}}},
+		}},
+		schemas: []reflect.Type{reflect.TypeOf(User{})},
+	},
+	"GET /api/v1/mlb/teams":       {summary: "List teams", tag: "teams", model: reflect.TypeOf(Team{}), params: []apiParameter{formatParam}},
+	"GET /api/v1/mlb/batting":     {summary: "List batting stats", tag: "stats", model: reflect.TypeOf(Batter{}), params: statParams},
+	"GET /api/v1/mlb/pitching":    {summary: "List pitching stats", tag: "stats", model: reflect.TypeOf(Pitcher{}), params: statParams},
+	"GET /api/v1/mlb/baserunning": {summary: "List baserunning stats", tag: "stats", model: reflect.TypeOf(Baserunner{}), params: statParams},
+	"GET /api/v1/mlb/splits/batting": {
+		summary: "List team batting splits", tag: "splits", model: reflect.TypeOf(BattingSplit{}), params: statParams,
+	},
+	"GET /api/v1/mlb/splits/pitching": {
+		summary: "List team pitching splits", tag: "splits", model: reflect.TypeOf(PitchingSplit{}), params: statParams,
+	},
+	"GET /api/v1/mlb/splits/homeaway/batting": {
+		summary: "List team home/away batting splits", tag: "splits", model: reflect.TypeOf(BattingHomeAway{}), params: statParams,
+	},
+	"GET /api/v1/mlb/splits/homeaway/pitching": {
+		summary: "List team home/away pitching splits", tag: "splits", model: reflect.TypeOf(PitchingHomeAway{}), params: statParams,
+	},
+	"GET /api/v1/mlb/opponents/batting": {
+		summary: "List batting against each team's pitchers", tag: "stats", model: reflect.TypeOf(BattingPitching{}), params: statParams,
+	},
+	"GET /api/v1/mlb/snapshots": {
+		summary: "List snapshot createddates and row counts", tag: "snapshots", model: reflect.TypeOf(Snapshot{}), params: []apiParameter{formatParam},
+	},
+	"GET /api/v1/mlb/players/{name}/history": {
+		summary: "List every snapshot row for a player", tag: "players", models: playerModels(),
+		description: "rows are Batter, Pitcher, Baserunner or BattingPitching objects depending on stat",
+		params: []apiParameter{
+			queryParam("stat", "stat table (default batting)", stringSchema("batting", "pitching", "baserunning", "batting_pitching")),
+			formatParam, fieldsParam, fromParam, toParam, filtersParam,
+		},
+	},
+	"GET /api/v1/mlb/batting/{teamabbrev}/diff": {
+		summary: "Per-player batting deltas between two snapshots", tag: "diff", model: reflect.TypeOf(BattingDelta{}),
+		params: []apiParameter{formatParam, fromParam, toParam},
+	},
+	"GET /api/v1/mlb/pitching/{teamabbrev}/diff": {
+		summary: "Per-player pitching deltas between two snapshots", tag: "diff", model: reflect.TypeOf(PitchingDelta{}),
+		params: []apiParameter{formatParam, fromParam, toParam},
+	},
+	"GET /api/v1/mlb/baserunning/{teamabbrev}/diff": {
+		summary: "Per-player baserunning deltas between two snapshots", tag: "diff", model: reflect.TypeOf(BaserunnerDelta{}),
+		params: []apiParameter{formatParam, fromParam, toParam},
+	},
+	"GET /api/v1/mlb/leaders/batting": {
+		summary: "League batting leaderboard", tag: "leaders", model: reflect.TypeOf(BattingLeader{}),
+		params: []apiParameter{
+			formatParam,
+			queryParam("sort", "field to rank by, prefixed with - for descending (default -ops)", stringSchema()),
+			queryParam("min_pa", "minimum plate appearances", &apiSchema{Type: "number"}),
+			queryParam("limit", fmt.Sprintf("number of ranks to return (default %d)", defaultLeaderLimit), &apiSchema{Type: "integer"}),
+			asofParam, snapshotParam, filtersParam,
+		},
+	},
+	"GET /api/v1/mlb/leaders/pitching": {
+		summary: "League pitching leaderboard", tag: "leaders", model: reflect.TypeOf(PitchingLeader{}),
+		params: []apiParameter{
+			formatParam,
+			queryParam("sort", "field to rank by, prefixed with - for descending (default era)", stringSchema()),
+			queryParam("min_ip", "minimum innings pitched", &apiSchema{Type: "number"}),
+			queryParam("limit", fmt.Sprintf("number of ranks to return (default %d)", defaultLeaderLimit), &apiSchema{Type: "integer"}),
+			asofParam, snapshotParam, filtersParam,
+		},
+	},
+	"GET /api/v1/mlb/freshness": {
+		summary: "Latest scrape success and failure per table", tag: "audit", model: reflect.TypeOf(Freshness{}), params: []apiParameter{formatParam},
+	},
+	"GET /api/v1/mlb/export/{table}": {
+		summary: "Export a stat table as Parquet or Arrow", tag: "export",
+		description: "from/to export every snapshot in the range; otherwise asof/snapshot select a single snapshot (the latest when unset)",
+		params: []apiParameter{
+			queryParam("format", "file format (default parquet)", stringSchema(exportParquet, exportArrow)),
+			queryParam("team", "team abbreviation", stringSchema()),
+			asofParam, snapshotParam, fromParam, toParam,
+		},
+		response: &apiResponse{Description: "export file", Content: map[string]apiMediaType{
+			exportContentTypes[exportParquet]: {Schema: &apiSchema{Type: "string", Format: "binary"}},
+			exportContentTypes[exportArrow]:   {Schema: &apiSchema{Type: "string", Format: "binary"}},
+		}},
+	},
+	"GET /graphql": {
+		summary: "Execute a GraphQL query", tag: "graphql",
+		params: []apiParameter{
+			{Name: "query", In: "query", Required: true, Schema: stringSchema()},
+			queryParam("variables", "JSON object of variables", stringSchema()),
+			queryParam("operationName", "operation to execute", stringSchema()),
+		},
+		response: &graphqlResponse,
+	},
+	"POST /graphql": {
+		summary: "Execute a GraphQL query", tag: "graphql",
+		body:     reflect.TypeOf(graphqlRequest{}),
+		response: &graphqlResponse,
+		schemas:  []reflect.Type{reflect.TypeOf(graphqlRequest{})},
+	},
+	"GET /api/v1/admin/audit": {
+		summary: "List scraper audit entries", tag: "audit", model: reflect.TypeOf(Audit{}),
+		params: []apiParameter{
+			formatParam,
+			queryParam("team", "team abbreviation", stringSchema()),
+			queryParam("table", "scraped table name", stringSchema()),
+			queryParam("status", "status label or id", stringSchema()),
+			fromParam, toParam,
+			queryParam("limit", "maximum number of entries", &apiSchema{Type: "integer"}),
+		},
+	},
+	"GET /api/v1/admin/cache": {
+		summary: "Response cache counters", tag: "admin",
+		response: &apiResponse{Description: "cache configuration and hit/miss counters", Content: map[string]apiMediaType{
+			"application/json": {Schema: refSchema(reflect.TypeOf(CacheStats{}))},
+		}},
+		schemas: []reflect.Type{reflect.TypeOf(CacheStats{})},
+	},
+	"GET /openapi.json": {
+		summary: "This OpenAPI document", tag: "docs",
+		response: &apiResponse{Description: "OpenAPI 3 document", Content: map[string]apiMediaType{"application/json": {Schema: &apiSchema{Type: "object"}}}},
+	},
+	"GET /docs": {
+		summary: "Interactive API explorer", tag: "docs",
+		response: &apiResponse{Description: "Swagger UI", Content: map[string]apiMediaType{"text/html": {Schema: stringSchema()}}},
+	},
+}
+
+var graphqlResponse = apiResponse{Description: "GraphQL result with data and errors", Content: map[string]apiMediaType{
+	"application/json": {Schema: &apiSchema{Type: "object"}},
+}}
+
+// modelSchema builds the object schema of a model struct from its json-tagged fields; null.* fields are nullable
+func modelSchema(model reflect.Type) *apiSchema {
+	schema := &apiSchema{Type: "object", Properties: map[string]*apiSchema{}}
+	for _, f := range jsonFields(model) {
+		var property *apiSchema
+		switch t := model.FieldByIndex(f.index).Type; t {
+		case intType:
+			property = &apiSchema{Type: "integer"}
+		case nullInt:
+			property = &apiSchema{Type: "integer", Nullable: true}
+		case nullFloat:
+			property = &apiSchema{Type: "number", Format: "double", Nullable: true}
+		case nullString:
+			property = &apiSchema{Type: "string", Nullable: true}
+		case nullTime:
+			property = &apiSchema{Type: "string", Format: "date-time", Nullable: true}
+		default:
+			switch t.Kind() {
+			case reflect.Map:
+				property = &apiSchema{Type: "object"}
+			case reflect.Bool:
+				property = &apiSchema{Type: "boolean"}
+			default:
+				property = &apiSchema{Type: "string"}
+			}
+		}
+		if !property.Nullable {
+			schema.Required = append(schema.Required, f.name)
+		}
+		schema.Properties[f.name] = property
+	}
+	return schema
+}
+
+// rowsResponse describes rows of one of models in each negotiated response format; the JSON rows of a paginated
+// route are wrapped in the Page envelope once a limit or cursor is given
+func rowsResponse(models []reflect.Type, paginated bool) apiResponse {
+	row := refSchema(models[0])
+	if len(models) > 1 {
+		row = &apiSchema{}
+		for _, model := range models {
+			row.OneOf = append(row.OneOf, refSchema(model))
+		}
+	}
+	rows := &apiSchema{Type: "array", Items: row}
+	jsonRows := rows
+	if paginated {
+		jsonRows = &apiSchema{OneOf: []*apiSchema{rows, pageSchema(rows)}}
+	}
+	return apiResponse{
+		Description: "rows in the negotiated format",
+		Headers: map[string]apiHeader{
+			"Link": {Description: "next and prev page links when paginated", Schema: stringSchema()},
+		},
+		Content: map[string]apiMediaType{
+			contentTypes[formatJSON]:   {Schema: jsonRows},
+			contentTypes[formatNDJSON]: {Schema: row},
+			contentTypes[formatCSV]:    {Schema: stringSchema()},
+			contentTypes[formatTSV]:    {Schema: stringSchema()},
+		},
+	}
+}
+
+// pageSchema describes the {data, next, prev} envelope jsonEncoder writes around a page of rows; next and prev are
+// always written, null at either end
+func pageSchema(rows *apiSchema) *apiSchema {
+	schema := modelSchema(reflect.TypeOf(Page{}))
+	schema.Properties["data"] = rows
+	schema.Required = []string{"data", "next", "prev"}
+	return schema
+}
+
+// paginated reports whether params include the page cursor
+func paginated(params []apiParameter) bool {
+	for _, param := range params {
+		if param.Name == cursorParam.Name && param.In == cursorParam.In {
+			return true
+		}
+	}
+	return false
+}
+
+// lookupRouteDoc finds the doc for a route, dropping trailing path parameters until an entry matches
+func lookupRouteDoc(method, route string) (routeDoc, bool) {
+	for {
+		if doc, ok := routeDocs[method+" "+route]; ok {
+			return doc, true
+		}
+		i := strings.LastIndex(route, "/")
+		if i <= 0 || !strings.HasPrefix(route[i:], "/{") {
+			return routeDoc{}, false
+		}
+		route = route[:i]
+	}
+}
+
+// operationID derives a stable identifier from the method and route pattern
+func operationID(method, route string) string {
+	var b strings.Builder
+	b.WriteString(strings.ToLower(method))
+	for _, part := range strings.FieldsFunc(route, func(r rune) bool { return r == '/' || r == '.' || r == '_' }) {
+		if strings.HasPrefix(part, "{") {
+			b.WriteString("By")
+			part = strings.Trim(part, "{}")
+		}
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return b.String()
+}
+
+// openAPIDocument describes every route registered on the router; routes under /api and /graphql require a bearer
+// token
+func (s *Server) openAPIDocument() (*openAPI, error) {
+	doc := &openAPI{
+		OpenAPI: "3.0.3",
+		Info: apiInfo{
+			Title:       "sports-data-api",
+			Version:     "1.0.0",
+			Description: "Baseball-Reference team stats scraped into Postgres; every /api route and /graphql need a token from /account/generateToken",
+		},
+		Paths: map[string]map[string]*apiOperation{},
+		Components: apiComponents{
+			Schemas: map[string]*apiSchema{
+				"Problem": modelSchema(reflect.TypeOf(Problem{})),
+			},
+			SecuritySchemes: map[string]apiSecurityScheme{
+				"bearerAuth":  {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+				"cookieToken": {Type: "apiKey", In: "cookie", Name: "token"},
+			},
+		},
+	}
+	err := chi.Walk(s.Router, func(method, route string, handler http.Handler, middlewares ...func(http.Handler) http.Handler) error {
+		route = strings.TrimSuffix(route, "/")
+		rd, ok := lookupRouteDoc(method, route)
+		if !ok {
+			rd = routeDoc{summary: method + " " + route}
+		}
+		op := &apiOperation{
+			Summary:     rd.summary,
+			Description: rd.description,
+			OperationID: operationID(method, route),
+			Responses: map[string]apiResponse{
+				"default": {Description: "RFC 7807 problem", Content: map[string]apiMediaType{
+					problemContentType: {Schema: refSchema(reflect.TypeOf(Problem{}))},
+				}},
+			},
+		}
+		if rd.tag != "" {
+			op.Tags = []string{rd.tag}
+		}
+		for _, part := range strings.Split(route, "/") {
+			if strings.HasPrefix(part, "{") {
+				name := strings.Trim(part, "{}")
+				param := pathParams[name]
+				param.Name, param.In, param.Required = name, "path", true
+				if param.Schema == nil {
+					param.Schema = stringSchema()
+				}
+				op.Parameters = append(op.Parameters, param)
+			}
+		}
+		op.Parameters = append(op.Parameters, rd.params...)
+		if rd.body != nil {
+			op.RequestBody = &apiRequestBody{Required: true, Content: map[string]apiMediaType{
+				"application/json": {Schema: refSchema(rd.body)},
+			}}
+		}
+		switch {
+		case rd.model != nil || rd.models != nil:
+			models := rd.models
+			if rd.model != nil {
+				models = []reflect.Type{rd.model}
+			}
+			op.Responses["200"] = rowsResponse(models, paginated(rd.params))
+			for _, model := range models {
+				doc.Components.Schemas[model.Name()] = modelSchema(model)
+			}
+		case rd.response != nil:
+			op.Responses["200"] = *rd.response
+		default:
+			op.Responses["200"] = apiResponse{Description: "success"}
+		}
+		for _, model := range rd.schemas {
+			doc.Components.Schemas[model.Name()] = modelSchema(model)
+		}
+		if strings.HasPrefix(route, "/api/") || route == "/graphql" {
+			op.Security = []map[string][]string{{"bearerAuth": {}}, {"cookieToken": {}}}
+		}
+		if doc.Paths[route] == nil {
+			doc.Paths[route] = map[string]*apiOperation{}
+		}
+		doc.Paths[route][strings.ToLower(method)] = op
+		return nil
+	})
+	return doc, err
+}
+
+// GetOpenAPI serves the OpenAPI 3 document generated from the registered routes and models; endpoint: /openapi.json
+func (s *Server) GetOpenAPI() http.HandlerFunc {
+	var (
+		once sync.Once
+		body []byte
+		err  error
+	)
+	return func(w http.ResponseWriter, r *http.Request) {
+		once.Do(func() {
+			var doc *openAPI
+			if doc, err = s.openAPIDocument(); err == nil {
+				body, err = json.Marshal(doc)
+			}
+		})
+		if ok := checkWriteError(w, r, err, http.StatusInternalServerError, codeInternal); ok {
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write(body); err != nil {
+			log.Println(errors.Wrap(err, "error writing openapi document"))
+		}
+	}
+}
+
+// docsPage loads Swagger UI from a CDN and points it at the generated document
+const docsPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+	<meta charset="utf-8">
+	<title>sports-data-api</title>
+	<link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5/swagger-ui.css">
+</head>
+<body>
+	<div id="swagger-ui"></div>
+	<script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js" crossorigin></script>
+	<script>
+		window.ui = SwaggerUIBundle({url: "/openapi.json", dom_id: "#swagger-ui", persistAuthorization: true});
+	</script>
+</body>
+</html>
+`
+
+// GetDocs serves Swagger UI over the OpenAPI document; endpoint: /docs
+func (s *Server) GetDocs() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if _, err := w.Write([]byte(docsPage)); err != nil {
+			log.Println(errors.Wrap(err, "error writing docs page"))
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestOpenAPIRowSchemas(t *testing.T) {
+	ts := newTestServer(t, fixtureRepository(t))
+	resp, body := ts.do(http.MethodGet, "/openapi.json", "")
+	var doc openAPI
+	if err := json.Unmarshal(body, &doc); err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("GET /openapi.json: %d %v", resp.StatusCode, err)
+	}
+	rows := func(route string) *apiSchema {
+		t.Helper()
+		op := doc.Paths[route]["get"]
+		if op == nil {
+			t.Fatalf("%s is not documented", route)
+		}
+		return op.Responses["200"].Content[contentTypes[formatJSON]].Schema
+	}
+
+	batting := rows("/api/v1/mlb/batting")
+	if len(batting.OneOf) != 2 {
+		t.Fatalf("/batting JSON schema %+v, want a bare array or a page", batting)
+	}
+	if bare := batting.OneOf[0]; bare.Type != "array" || bare.Items.Ref != "#/components/schemas/Batter" {
+		t.Errorf("/batting unpaginated schema %+v, want an array of Batter", bare)
+	}
+	page := batting.OneOf[1]
+	data := page.Properties["data"]
+	if page.Type != "object" || data == nil || data.Items == nil || data.Items.Ref != "#/components/schemas/Batter" ||
+		!page.Properties["next"].Nullable || !page.Properties["prev"].Nullable {
+		t.Errorf("/batting page schema %+v, want data rows with nullable next and prev links", page)
+	}
+
+	if teams := rows("/api/v1/mlb/teams"); teams.Type != "array" || teams.OneOf != nil {
+		t.Errorf("/teams schema %+v, want a bare array", teams)
+	}
+	if leaders := rows("/api/v1/mlb/leaders/batting"); leaders.Type != "array" {
+		t.Errorf("/leaders/batting schema %+v, want a bare array", leaders)
+	}
+
+	history := rows("/api/v1/mlb/players/{name}/history")
+	var refs []string
+	if history.Type == "array" {
+		for _, s := range history.Items.OneOf {
+			refs = append(refs, s.Ref)
+			if doc.Components.Schemas[s.Ref[len("#/components/schemas/"):]] == nil {
+				t.Errorf("history row %s has no component schema", s.Ref)
+			}
+		}
+	}
+	if len(refs) != len(playerTables) {
+		t.Errorf("history rows %v, want one of the %d player tables", refs, len(playerTables))
+	}
+}
@@ -0,0 +1,161 @@
+package app
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v3"
+)
+
+const (
+	defaultPageLimit = 100
+	maxPageLimit     = 1000
+)
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+// pageCursor is the keyset position a page starts after (or before, when Prev is set); it is opaque to clients
+type pageCursor struct {
+	Teamid int  `json:"t"`
+	ID     int  `json:"i"`
+	Prev   bool `json:"p,omitempty"`
+}
+
+// page requests at most limit rows ordered by (teamid, id), starting from cursor when set
+type page struct {
+	limit  int
+	cursor *pageCursor
+}
+
+func (c pageCursor) encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(value string) (*pageCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+	c := &pageCursor{}
+	if err = json.Unmarshal(b, c); err != nil {
+		return nil, errInvalidCursor
+	}
+	return c, nil
+}
+
+// parsePage reads the limit and cursor parameters; a nil page means the request is not paginated
+func parsePage(r *http.Request) (*page, error) {
+	limit, cursor := r.URL.Query().Get("limit"), r.URL.Query().Get("cursor")
+	if limit == "" && cursor == "" {
+		return nil, nil
+	}
+	p := &page{limit: defaultPageLimit}
+	if limit != "" {
+		var err error
+		if p.limit, err = strconv.Atoi(limit); err != nil || p.limit < 1 || p.limit > maxPageLimit {
+			return nil, errors.Errorf("limit must be between 1 and %d", maxPageLimit)
+		}
+	}
+	if cursor != "" {
+		var err error
+		if p.cursor, err = decodeCursor(cursor); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// columns adds the page key to a select list
+func (p *page) columns(columns string, fields []string) string {
+	if fields != nil && !contains(fields, "id") {
+		columns += ", id"
+	}
+	return columns + ", teamid"
+}
+
+// clause appends the keyset condition to where and returns the ordering and limit for the page; one row past the
+// limit is fetched to detect whether another page follows
+func (p *page) clause(d dialect, where []string, args []interface{}) ([]string, []interface{}, string) {
+	direction, op := "ASC", ">"
+	if p.cursor != nil {
+		if p.cursor.Prev {
+			direction, op = "DESC", "<"
+		}
+		var key string
+		key, args = d.keyCompare(op, p.cursor.Teamid, p.cursor.ID, args)
+		where = append(where, key)
+	}
+	args = append(args, p.limit+1)
+	return where, args, fmt.Sprintf("ORDER BY x.teamid %[1]s, x.id %[1]s\n\t\t%[2]s", direction, d.fetch)
+}
+
+// paginate trims the extra row fetched by clause from a slice of model structs, restores ascending order and
+// returns the page with links to its neighbours
+func (p *page) paginate(r *http.Request, rows reflect.Value) (reflect.Value, Page) {
+	backward := p.cursor != nil && p.cursor.Prev
+	more := rows.Len() > p.limit
+	if more {
+		rows = rows.Slice(0, p.limit)
+	}
+	if backward {
+		swap := reflect.Swapper(rows.Interface())
+		for i, j := 0, rows.Len()-1; i < j; i, j = i+1, j-1 {
+			swap(i, j)
+		}
+	}
+	var env Page
+	if rows.Len() == 0 {
+		return rows, env
+	}
+	key := func(v reflect.Value) pageCursor {
+		return pageCursor{Teamid: int(v.FieldByName("Teamid").Int()), ID: int(v.FieldByName("ID").Int())}
+	}
+	// paging backward always leaves a following page; paging forward from a cursor always leaves a preceding one
+	if backward || more {
+		next := key(rows.Index(rows.Len() - 1))
+		env.Next = null.StringFrom(pageLink(r, next))
+	}
+	if (p.cursor != nil && !backward) || (backward && more) {
+		prev := key(rows.Index(0))
+		prev.Prev = true
+		env.Prev = null.StringFrom(pageLink(r, prev))
+	}
+	return rows, env
+}
+
+// pageLink rewrites the request URL with a new cursor, keeping every other parameter
+func pageLink(r *http.Request, c pageCursor) string {
+	query := r.URL.Query()
+	query.Set("cursor", c.encode())
+	link := url.URL{Path: r.URL.Path, RawQuery: query.Encode()}
+	return link.String()
+}
+
+// linkHeader renders the page links as an RFC 8288 Link header value
+func (env Page) linkHeader() string {
+	var links []string
+	if env.Next.Valid {
+		links = append(links, fmt.Sprintf(`<%s>; rel="next"`, env.Next.String))
+	}
+	if env.Prev.Valid {
+		links = append(links, fmt.Sprintf(`<%s>; rel="prev"`, env.Prev.String))
+	}
+	return strings.Join(links, ", ")
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	for _, c := range []pageCursor{{}, {Teamid: 1, ID: 2}, {Teamid: 30, ID: 123456, Prev: true}} {
+		got, err := decodeCursor(c.encode())
+		if err != nil || *got != c {
+			t.Errorf("decodeCursor(encode(%+v)) = %+v, %v", c, got, err)
+		}
+		if strings.ContainsAny(c.encode(), "+/=") {
+			t.Errorf("cursor %q is not URL safe", c.encode())
+		}
+	}
+	for _, value := range []string{"!!", "bm90IGpzb24", ""} {
+		if _, err := decodeCursor(value); err != errInvalidCursor {
+			t.Errorf("decodeCursor(%q) = %v, want errInvalidCursor", value, err)
+		}
+	}
+}
+
+// pageResponse is the envelope of a paginated list response
+type pageResponse struct {
+	Data []Batter `json:"data"`
+	Page
+}
+
+func TestPaging(t *testing.T) {
+	for backend, ts := range testServers(t) {
+		fetch := func(path string) (ids []int, env Page) {
+			t.Helper()
+			resp, body := ts.do(http.MethodGet, path, "")
+			var pr pageResponse
+			if err := json.Unmarshal(body, &pr); err != nil || resp.StatusCode != http.StatusOK {
+				t.Fatalf("%s: GET %s: %d %s", backend, path, resp.StatusCode, body)
+			}
+			if link := resp.Header.Get("Link"); link != pr.Page.linkHeader() {
+				t.Errorf("%s: GET %s: Link %q, want %q", backend, path, link, pr.Page.linkHeader())
+			}
+			for _, b := range pr.Data {
+				ids = append(ids, b.ID)
+			}
+			return ids, pr.Page
+		}
+		// the latest batting snapshots are BOS ids 1 and 2 and NYY ids 5 and 6; single row pages cross the team
+		// boundary between 2 and 5
+		var forward []int
+		path := "/api/v1/mlb/batting?limit=1&fields=id,name"
+		var env Page
+		for i := 0; i < 5 && path != ""; i++ {
+			var ids []int
+			ids, env = fetch(path)
+			forward = append(forward, ids...)
+			if i == 0 && env.Prev.Valid {
+				t.Errorf("%s: first page has a prev link", backend)
+			}
+			if i > 0 && !env.Prev.Valid {
+				t.Errorf("%s: page %d has no prev link", backend, i)
+			}
+			path = ""
+			if env.Next.Valid {
+				path = env.Next.String
+			}
+		}
+		if want := []int{1, 2, 5, 6}; !reflect.DeepEqual(forward, want) {
+			t.Errorf("%s: walked next through %v, want %v", backend, forward, want)
+		}
+		var backward []int
+		path = "/api/v1/mlb/batting?limit=1&fields=id,name&cursor=" + pageCursor{Teamid: 2, ID: 6, Prev: true}.encode()
+		for i := 0; i < 5 && path != ""; i++ {
+			var ids []int
+			ids, env = fetch(path)
+			backward = append(ids, backward...)
+			if !env.Next.Valid {
+				t.Errorf("%s: page before the last has no next link", backend)
+			}
+			path = ""
+			if env.Prev.Valid {
+				path = env.Prev.String
+			}
+		}
+		if want := []int{1, 2, 5}; !reflect.DeepEqual(backward, want) {
+			t.Errorf("%s: walked prev through %v, want %v", backend, backward, want)
+		}
+		// links keep the other parameters and only replace the cursor
+		ids, env := fetch("/api/v1/mlb/batting?limit=3&fields=id,name&pos[in]=C,RF,2B,1B")
+		if len(ids) != 3 || !env.Next.Valid {
+			t.Fatalf("%s: got %v next %v, want 3 rows and a next link", backend, ids, env.Next)
+		}
+		next, err := url.Parse(env.Next.String)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if q := next.Query(); next.Path != "/api/v1/mlb/batting" || q.Get("limit") != "3" || q.Get("fields") != "id,name" || q.Get("pos[in]") == "" || q.Get("cursor") == "" {
+			t.Errorf("%s: next link %s lost parameters", backend, env.Next.String)
+		}
+		if p := ts.problem("/api/v1/mlb/batting?cursor=garbage", http.StatusBadRequest); p.Code != codeInvalidParameter {
+			t.Errorf("%s: invalid cursor: code %q, want %q", backend, p.Code, codeInvalidParameter)
+		}
+	}
+}
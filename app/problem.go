@@ -0,0 +1,104 @@
+package app
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/go-chi/chi/middleware"
+	"github.com/pkg/errors"
+)
+
+// error codes reported in problem responses; clients match on these rather than on detail
+const (
+	codeInvalidParameter   = "invalid_parameter"
+	codeInvalidBody        = "invalid_request_body"
+	codeUnknownTable       = "unknown_table"
+	codeUnknownStat        = "unknown_stat"
+	codeUnknownTeam        = "unknown_team"
+	codeMissingToken       = "missing_token"
+	codeInvalidToken       = "invalid_token"
+	codeInvalidCredentials = "invalid_credentials"
+	codeNotFound           = "not_found"
+	codeMethodNotAllowed   = "method_not_allowed"
+	codeDatabase           = "database_error"
+	codeInternal           = "internal_error"
+)
+
+// problemContentType is the RFC 7807 media type every error response is written with
+const problemContentType = "application/problem+json"
+
+// Problem represents an RFC 7807 problem details response
+type Problem struct {
+	Type      string   `json:"type"`
+	Title     string   `json:"title"`
+	Status    int      `json:"status"`
+	Code      string   `json:"code"`
+	Detail    string   `json:"detail,omitempty"`
+	Instance  string   `json:"instance,omitempty"`
+	RequestID string   `json:"requestid,omitempty"`
+	Valid     []string `json:"valid,omitempty"` // accepted values for the rejected parameter
+}
+
+// apiError is an error reported to the client with an HTTP status and a stable error code
+type apiError struct {
+	status int
+	code   string
+	err    error
+	valid  []string // accepted values reported with the problem
+}
+
+func newAPIError(status int, code string, err error) *apiError {
+	return &apiError{status: status, code: code, err: err}
+}
+
+func (e *apiError) Error() string {
+	return e.err.Error()
+}
+
+// writeProblem writes err as a problem response; errors other than *apiError are reported as internal errors.
+// Server errors are logged with the request ID and their detail is withheld from the client
+func writeProblem(w http.ResponseWriter, r *http.Request, err error) {
+	e, ok := err.(*apiError)
+	if !ok {
+		e = newAPIError(http.StatusInternalServerError, codeInternal, err)
+	}
+	p := Problem{
+		Type:      "about:blank",
+		Title:     http.StatusText(e.status),
+		Status:    e.status,
+		Code:      e.code,
+		Detail:    e.err.Error(),
+		Instance:  r.URL.Path,
+		RequestID: middleware.GetReqID(r.Context()),
+		Valid:     e.valid,
+	}
+	if e.status >= http.StatusInternalServerError {
+		log.Println(errors.Wrapf(e.err, "request %s", p.RequestID))
+		p.Detail = ""
+	}
+	w.Header().Set("Content-Type", problemContentType)
+	w.WriteHeader(e.status)
+	if err := json.NewEncoder(w).Encode(p); err != nil {
+		log.Println(errors.Wrap(err, "error encoding problem response"))
+	}
+}
+
+// checkWriteError writes a problem response with status and code when err is set and reports whether it did
+func checkWriteError(w http.ResponseWriter, r *http.Request, err error, status int, code string) bool {
+	if err != nil {
+		writeProblem(w, r, newAPIError(status, code, err))
+		return true
+	}
+	return false
+}
+
+// notFound answers requests that match no route
+func notFound(w http.ResponseWriter, r *http.Request) {
+	writeProblem(w, r, newAPIError(http.StatusNotFound, codeNotFound, errors.Errorf("no route for %s", r.URL.Path)))
+}
+
+// methodNotAllowed answers requests whose route does not accept the method
+func methodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	writeProblem(w, r, newAPIError(http.StatusMethodNotAllowed, codeMethodNotAllowed, errors.Errorf("%s not allowed for %s", r.Method, r.URL.Path)))
+}
@@ -0,0 +1,98 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// projected is a model struct restricted to a subset of its fields; it encodes as a JSON object in field order
+type projected struct {
+	v      reflect.Value
+	fields [][]int
+	names  []string
+}
+
+// MarshalJSON writes only the projected fields
+func (p projected) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, idx := range p.fields {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, _ := json.Marshal(p.names[i])
+		buf.Write(name)
+		buf.WriteByte(':')
+		value, err := json.Marshal(p.v.FieldByIndex(idx).Interface())
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// jsonField is a json-tagged field of a model struct; index is its path for reflect.Value.FieldByIndex
+type jsonField struct {
+	name  string
+	index []int
+}
+
+// jsonFields lists the json-tagged fields of a model struct in declaration order, flattening embedded structs the
+// way encoding/json does; json tags mirror column names
+func jsonFields(model reflect.Type) []jsonField {
+	fields := []jsonField{}
+	for i := 0; i < model.NumField(); i++ {
+		f := model.Field(i)
+		tag := strings.Split(f.Tag.Get("json"), ",")[0]
+		if f.Anonymous && f.Type.Kind() == reflect.Struct && tag == "" {
+			for _, embedded := range jsonFields(f.Type) {
+				fields = append(fields, jsonField{name: embedded.name, index: append([]int{i}, embedded.index...)})
+			}
+			continue
+		}
+		if tag != "" && tag != "-" {
+			fields = append(fields, jsonField{name: tag, index: []int{i}})
+		}
+	}
+	return fields
+}
+
+// fieldIndex maps the json tag of each field of a model struct to its index path
+func fieldIndex(model reflect.Type) map[string][]int {
+	fields := jsonFields(model)
+	index := make(map[string][]int, len(fields))
+	for _, f := range fields {
+		index[f.name] = f.index
+	}
+	return index
+}
+
+// parseFields validates the comma separated fields parameter against the json tags of model; nil means all fields
+func parseFields(r *http.Request, model reflect.Type) ([]string, error) {
+	param := r.URL.Query().Get("fields")
+	if param == "" {
+		return nil, nil
+	}
+	index := fieldIndex(model)
+	fields := []string{}
+	seen := map[string]bool{}
+	for _, field := range strings.Split(param, ",") {
+		field = strings.ToLower(strings.TrimSpace(field))
+		if field == "" || seen[field] {
+			continue
+		}
+		if _, ok := index[field]; !ok {
+			return nil, errors.Errorf("unknown field: %s", field)
+		}
+		seen[field] = true
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
@@ -0,0 +1,215 @@
+package app
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// select lists for each baseballreference stat table; columns are read from the ranked subquery aliased x
+const (
+	pitchingColumns = `id, teamabbrev, rk, pos, name, age, w, l, wl, era, g, gs, gf, cg, sho, sv, ip, h, r,
+		er, hr, bb, ibb, so, hbp, bk, wp, bf, eraplus, fip, whip, h9, hr9, bb9, so9, sow, createddate`
+	battingColumns = `id, teamabbrev, rk, pos, name, age, g, pa, ab, r, h, twob, threeb, hr, rbi,
+		sb, cs, bb, so, ba, obp, slg, ops, opsplus, tb, gdp, hbp, sh, sf, ibb, createddate`
+	battingSplitColumns = `id, teamabbrev, split, g, gs, pa, ab, r, h, twob, threeb, hr, rbi, sb, cs, bb, so, ba,
+		obp, slg, ops, tb, gdp, hbp, sh, sf, ibb, roe, babip, topsplus, sopsplus, createddate`
+	pitchingSplitColumns = `id, teamabbrev, split, g, pa, ab, r, h, twob, threeb, hr, sb, cs, bb, so, sow, ba, obp, slg,
+		ops, tb, gdp, hbp, sh, sf, ibb, roe, babip, topsplus, sopsplus, createddate`
+	battingPitchingColumns = `id, teamabbrev, rk, name, age, ip, g, pa, ab, r, h, twob, threeb, hr, sb, cs, bb, so,
+		ba, obp, slg, ops, tb, gdp, hbp, sh, sf, ibb, roe, babip, createddate`
+	baserunningColumns = `id, teamabbrev, name, age, pa, roe, xi, rspct, sbo, sb, cs, sbpct, sb2, cs2, sb3, cs3, sbh, csh,
+		po, pcs, oob, oob1, oob2, oob3, oobhm, bt, xbtpct, firsts, firsts2, firsts3, firstd, firstd3, firstdh, seconds, seconds3, secondsh, createddate`
+)
+
+// statTable pairs a baseballreference stat table with its select list and model struct
+type statTable struct {
+	name    string
+	columns string
+	model   reflect.Type
+}
+
+// statTables are the team-scoped stat tables served by the list endpoints
+var statTables = map[string]statTable{
+	"batting":            {name: "batting", columns: battingColumns, model: reflect.TypeOf(Batter{})},
+	"pitching":           {name: "pitching", columns: pitchingColumns, model: reflect.TypeOf(Pitcher{})},
+	"batting_splits":     {name: "batting_splits", columns: battingSplitColumns, model: reflect.TypeOf(BattingSplit{})},
+	"pitching_splits":    {name: "pitching_splits", columns: pitchingSplitColumns, model: reflect.TypeOf(PitchingSplit{})},
+	"baserunning":        {name: "baserunning", columns: baserunningColumns, model: reflect.TypeOf(Baserunner{})},
+	"batting_pitching":   {name: "batting_pitching", columns: battingPitchingColumns, model: reflect.TypeOf(BattingPitching{})},
+	"batting_home_away":  {name: "batting_home_away", columns: battingSplitColumns, model: reflect.TypeOf(BattingHomeAway{})},
+	"pitching_home_away": {name: "pitching_home_away", columns: pitchingSplitColumns, model: reflect.TypeOf(PitchingHomeAway{})},
+}
+
+// playerTables are the per-player stat tables, keyed by the stat query parameter
+var playerTables = map[string]statTable{
+	"batting":          statTables["batting"],
+	"pitching":         statTables["pitching"],
+	"baserunning":      statTables["baserunning"],
+	"batting_pitching": statTables["batting_pitching"],
+}
+
+// selectList returns the columns for a projection over t, or every column when fields is nil
+func (t statTable) selectList(fields []string) string {
+	if fields == nil {
+		return t.columns
+	}
+	return strings.Join(fields, ", ")
+}
+
+// statQuery builds a select of the most recent snapshot per team from a baseballreference stat table
+type statQuery struct {
+	table   string   // table name within the baseballreference schema
+	columns string   // select list
+	team    string   // optional team abbreviation
+	snap    snapshot // optional createddate bounds applied before ranking
+	filters []filter // optional comparisons applied to the ranked rows
+	page    *page    // optional keyset page ordered by (teamid, id)
+	sort    string   // optional column ordering an unpaginated select
+	desc    bool     // sort descending
+	limit   int      // optional row limit for an unpaginated select
+	derived bool     // leave out the default ordering, as SQL Server rejects ORDER BY in a derived table
+}
+
+// build returns the SQL statement in dialect d and its positional arguments
+func (q statQuery) build(d dialect) (string, []interface{}) {
+	var (
+		where []string
+		args  []interface{}
+	)
+	if q.team != "" {
+		args = append(args, q.team)
+		where = append(where, "t.teamabbrev = ?")
+	}
+	where, args = q.snap.conditions("p.createddate", where, args)
+	where = d.visible(where)
+	var whereClause string
+	if len(where) > 0 {
+		whereClause = "WHERE	" + strings.Join(where, "\n\t\t\t\t\tAND\t")
+	}
+	outer, args := conditions(d, q.filters, []string{"rnk = 1"}, args)
+	orderClause := "ORDER BY x.teamid, x.id"
+	switch {
+	case q.page != nil:
+		outer, args, orderClause = q.page.clause(d, outer, args)
+	case q.derived:
+		orderClause = ""
+	case q.sort != "" || q.limit > 0:
+		if q.sort != "" {
+			direction := "ASC"
+			if q.desc {
+				direction = "DESC"
+			}
+			orderClause = fmt.Sprintf("ORDER BY %s, x.teamid, x.id", d.orderNullsLast("x."+q.sort, direction))
+		}
+		if q.limit > 0 {
+			args = append(args, q.limit)
+			orderClause += "\n\t\t" + d.fetch
+		}
+	}
+	query := fmt.Sprintf(
+		`SELECT	%s
+		FROM 	(
+					SELECT	p.*, t.teamabbrev, DENSE_RANK() OVER(PARTITION BY teamid ORDER BY createddate DESC) AS rnk
+					FROM	%s p
+							INNER JOIN %s t ON t.id = p.teamid
+					%s
+				) x
+		WHERE	%s
+		%s`,
+		q.columns, d.table(q.table), d.table("team"), whereClause, strings.Join(outer, "\n\t\tAND\t"), orderClause,
+	)
+	return query, args
+}
+
+// historyQuery builds a select of every snapshot row for a single player ordered by createddate; names are
+// matched without the handedness markers (* and #) Baseball-Reference appends
+func historyQuery(d dialect, table, columns, name string, snap snapshot, filters []filter) (string, []interface{}) {
+	args := []interface{}{name}
+	where := []string{d.trimMarkers("p.name") + " = ?"}
+	where, args = snap.conditions("p.createddate", where, args)
+	where = d.visible(where)
+	outer, args := conditions(d, filters, nil, args)
+	var outerClause string
+	if len(outer) > 0 {
+		outerClause = "WHERE	" + strings.Join(outer, "\n\t\tAND\t")
+	}
+	query := fmt.Sprintf(
+		`SELECT	%s
+		FROM 	(
+					SELECT	p.*, t.teamabbrev
+					FROM	%s p
+							INNER JOIN %s t ON t.id = p.teamid
+					WHERE	%s
+				) x
+		%s
+		ORDER BY createddate, id`,
+		columns, d.table(table), d.table("team"), strings.Join(where, "\n\t\t\t\t\tAND\t"), outerClause,
+	)
+	return query, args
+}
+
+// rangeQuery builds a select of every snapshot row of a stat table with a createddate inside snap, for all teams
+// or a single team
+func rangeQuery(d dialect, table, columns, team string, snap snapshot) (string, []interface{}) {
+	var (
+		where []string
+		args  []interface{}
+	)
+	if team != "" {
+		args = append(args, team)
+		where = append(where, "t.teamabbrev = ?")
+	}
+	where, args = snap.conditions("p.createddate", where, args)
+	where = d.visible(where)
+	var whereClause string
+	if len(where) > 0 {
+		whereClause = "WHERE	" + strings.Join(where, "\n\t\t\t\t\tAND\t")
+	}
+	query := fmt.Sprintf(
+		`SELECT	%s
+		FROM 	(
+					SELECT	p.*, t.teamabbrev
+					FROM	%s p
+							INNER JOIN %s t ON t.id = p.teamid
+					%s
+				) x
+		ORDER BY createddate, teamid, id`,
+		columns, d.table(table), d.table("team"), whereClause,
+	)
+	return query, args
+}
+
+// lastModifiedQuery builds a select of the newest change to a stat table within snap, for all teams or a single
+// team: when a load run wrote a row, the run's completion, and otherwise the row's createddate. It changes whenever
+// the scraper inserts a snapshot the matching stat queries could return, and whenever a retried run rewrites the rows
+// of a snapshot in place at the same createddate
+func lastModifiedQuery(d dialect, table, team string, snap snapshot) (string, []interface{}) {
+	var (
+		where []string
+		args  []interface{}
+	)
+	if team != "" {
+		args = append(args, team)
+		where = append(where, "t.teamabbrev = ?")
+	}
+	where, args = snap.conditions("p.createddate", where, args)
+	where = d.visible(where)
+	var whereClause string
+	if len(where) > 0 {
+		whereClause = "WHERE	" + strings.Join(where, "\n\t\tAND\t")
+	}
+	modified, runs := "p.createddate", ""
+	if d.runs {
+		modified = "COALESCE(r.completedat, p.createddate)"
+		runs = fmt.Sprintf("\n\t\t\t\tLEFT JOIN %s r ON r.id = p.runid", d.table("load_run"))
+	}
+	query := fmt.Sprintf(
+		`SELECT	MAX(%s)
+		FROM	%s p
+				INNER JOIN %s t ON t.id = p.teamid%s
+		%s`,
+		modified, d.table(table), d.table("team"), runs, whereClause,
+	)
+	return query, args
+}
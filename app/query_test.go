@@ -0,0 +1,89 @@
+package app
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// golden compares a query rendered in a dialect, followed by its arguments, with testdata/query/<name>.sql
+func golden(t *testing.T, name string, d dialect, query string, args []interface{}) {
+	t.Helper()
+	got := fmt.Sprintf("%s\n-- args: %v\n", d.rebind(query), args)
+	path := filepath.Join("testdata", "query", name+".sql")
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(got), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%v (run go test -update to create it)", err)
+	}
+	if got != string(want) {
+		t.Errorf("%s:\ngot\n%s\nwant\n%s", path, got, want)
+	}
+}
+
+func TestDialectFor(t *testing.T) {
+	for driver := range dialects {
+		if _, err := dialectFor(driver); err != nil {
+			t.Errorf("dialectFor(%q): %v", driver, err)
+		}
+	}
+	if _, err := dialectFor("oracle"); err == nil {
+		t.Error("dialectFor(\"oracle\"): want an error for a driver without a dialect")
+	}
+}
+
+func TestStatQueryBuild(t *testing.T) {
+	asof := time.Date(2020, 8, 2, 23, 59, 59, 0, time.UTC)
+	hr, err := newFilter(reflect.TypeOf(Batter{}), "hr", "gte", "10")
+	if err != nil {
+		t.Fatal(err)
+	}
+	name, err := newFilter(reflect.TypeOf(Batter{}), "name", "like", "%judge")
+	if err != nil {
+		t.Fatal(err)
+	}
+	queries := map[string]statQuery{
+		"latest": {table: "batting", columns: "id, name", team: "NYY"},
+		"sorted": {
+			table: "batting", columns: "id, name, hr", snap: snapshot{to: asof}, filters: []filter{hr, name},
+			sort: "hr", desc: true, limit: 5,
+		},
+		"page": {
+			table: "batting", columns: "id, name, teamid",
+			page: &page{limit: 2, cursor: &pageCursor{Teamid: 1, ID: 2, Prev: true}},
+		},
+	}
+	for driver, d := range dialects {
+		for name, q := range queries {
+			query, args := q.build(d)
+			golden(t, driver+"_"+name, d, query, args)
+		}
+	}
+}
+
+func TestLeaderQuery(t *testing.T) {
+	exact := time.Date(2020, 8, 8, 6, 0, 0, 0, time.UTC)
+	pos, err := newFilter(reflect.TypeOf(Batter{}), "pos", "in", "1B,RF")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for driver, d := range dialects {
+		query, args := leaderboards["batting"].leaderQuery(d, leaderParams{sort: "ops", desc: true, min: 100, limit: 10}, snapshot{from: exact, to: exact}, []filter{pos})
+		golden(t, driver+"_leaders_batting", d, query, args)
+		query, args = leaderboards["pitching"].leaderQuery(d, leaderParams{sort: "era", limit: 25}, snapshot{}, nil)
+		golden(t, driver+"_leaders_pitching", d, query, args)
+	}
+}
@@ -0,0 +1,236 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"reflect"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+	"gopkg.in/guregu/null.v3"
+)
+
+// StatsRepository reads the teams, stat snapshots, audit log and API users the handlers serve. SQLRepository
+// runs the queries built in this package against Postgres, MySQL, SQL Server or SQLite; MemoryRepository answers
+// the same requests from JSON fixtures so routing, authentication and encoding can be exercised without a database
+type StatsRepository interface {
+	// Teams returns every team ordered by abbreviation
+	Teams(ctx context.Context) ([]Team, error)
+	// UserRole returns the role of an API user, or "" when the credentials do not match a user
+	UserRole(ctx context.Context, username, password string) (string, error)
+	// Stats returns the rows of the latest snapshot per team selected by q
+	Stats(ctx context.Context, q statQuery) (Rows, error)
+	// History returns every snapshot row of a single player ordered by createddate
+	History(ctx context.Context, table statTable, columns, name string, snap snapshot, filters []filter) (Rows, error)
+	// Range returns every snapshot row of a stat table with a createddate inside snap
+	Range(ctx context.Context, table statTable, team string, snap snapshot) (Rows, error)
+	// Snapshots lists the createddate snapshots held per table and team
+	Snapshots(ctx context.Context, tables []string, team string) ([]Snapshot, error)
+	// LastModified returns the newest change to a stat table within snap: the completion of the load run that wrote
+	// a row, or else its createddate; null when no rows match
+	LastModified(ctx context.Context, table, team string, snap snapshot) (null.Time, error)
+	// Leaders returns the league-wide leaderboard rows of lb, scanned into lb.leader
+	Leaders(ctx context.Context, lb leaderboard, p leaderParams, snap snapshot, filters []filter) (Rows, error)
+	// Audit returns the scraper audit rows selected by f, newest first
+	Audit(ctx context.Context, f auditFilter) ([]Audit, error)
+	// Freshness returns the latest successful and failed scrape per table per team
+	Freshness(ctx context.Context, team string) ([]Freshness, error)
+}
+
+// Rows iterates a result set one model struct at a time; *sqlx.Rows satisfies it
+type Rows interface {
+	Next() bool
+	StructScan(dest interface{}) error
+	Err() error
+	Close() error
+}
+
+// selectAll scans every row of a result set into dest, a pointer to a slice of model structs, and closes it
+func selectAll(rows Rows, dest interface{}) error {
+	defer rows.Close()
+	slice := reflect.ValueOf(dest).Elem()
+	model := slice.Type().Elem()
+	for rows.Next() {
+		v := reflect.New(model)
+		if err := rows.StructScan(v.Interface()); err != nil {
+			return errors.Wrap(err, "error scanning row")
+		}
+		slice.Set(reflect.Append(slice, v.Elem()))
+	}
+	return errors.Wrap(rows.Err(), "error reading rows")
+}
+
+// selectRows scans every row of a result set into a new slice of model structs
+func selectRows(rows Rows, model reflect.Type) (interface{}, error) {
+	dest := reflect.New(reflect.SliceOf(model))
+	if err := selectAll(rows, dest.Interface()); err != nil {
+		return nil, err
+	}
+	return dest.Elem().Interface(), nil
+}
+
+// SQLRepository runs the baseballreference queries against a connection pool, rendering them in the dialect of
+// the pool's driver
+type SQLRepository struct {
+	Db      *sqlx.DB
+	dialect dialect
+}
+
+// NewSQLRepository creates a repository over an open connection pool; a nil pool and drivers without a dialect are
+// rejected. Rows of unfinished load runs are only hidden when the schema has the load_run table, so a database the
+// migrations have not been run on is served as the scraper wrote it
+func NewSQLRepository(db *sqlx.DB) (*SQLRepository, error) {
+	if db == nil {
+		return nil, errors.New("no database connection")
+	}
+	d, err := dialectFor(db.DriverName())
+	if err != nil {
+		return nil, err
+	}
+	if d.runs {
+		if _, err := db.Exec(fmt.Sprintf("SELECT 1 FROM %s WHERE 1 = 0", d.table("load_run"))); err != nil {
+			log.Println("no load_run table, so rows of unfinished load runs are not hidden; run `server migrate up`")
+			d.runs = false
+		}
+	}
+	return &SQLRepository{Db: db, dialect: d}, nil
+}
+
+// queryx runs a query, returning a nil interface rather than a nil *sqlx.Rows on error
+func (sr *SQLRepository) queryx(ctx context.Context, query string, args []interface{}) (Rows, error) {
+	rows, err := sr.Db.QueryxContext(ctx, sr.dialect.rebind(query), args...)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// selectContext runs a query and scans every row into dest
+func (sr *SQLRepository) selectContext(ctx context.Context, dest interface{}, query string, args []interface{}) error {
+	return sr.Db.SelectContext(ctx, dest, sr.dialect.rebind(query), args...)
+}
+
+// Teams returns every team in the team table
+func (sr *SQLRepository) Teams(ctx context.Context) ([]Team, error) {
+	teams := []Team{}
+	query := fmt.Sprintf("SELECT id, teamname, teamabbrev FROM %s ORDER BY teamabbrev", sr.dialect.table("team"))
+	err := sr.selectContext(ctx, &teams, query, nil)
+	return teams, err
+}
+
+// UserRole executes the basic_auth.user_role(user, pass) function; null means the credentials do not exist. Where the
+// dialect cannot check bcrypt in SQL the stored hash is read and compared here
+func (sr *SQLRepository) UserRole(ctx context.Context, username, password string) (string, error) {
+	if sr.dialect.userPass != "" {
+		var user struct {
+			Role string
+			Pass string
+		}
+		err := sr.Db.GetContext(ctx, &user, sr.dialect.rebind(sr.dialect.userPass), username)
+		if err == sql.ErrNoRows {
+			return "", nil
+		}
+		if err != nil || bcrypt.CompareHashAndPassword([]byte(user.Pass), []byte(password)) != nil {
+			return "", err
+		}
+		return user.Role, nil
+	}
+	var role null.String
+	err := sr.Db.GetContext(ctx, &role, sr.dialect.rebind(sr.dialect.userRole), username, password)
+	return role.String, err
+}
+
+// Stats runs the ranked latest snapshot select built by q
+func (sr *SQLRepository) Stats(ctx context.Context, q statQuery) (Rows, error) {
+	query, args := q.build(sr.dialect)
+	return sr.queryx(ctx, query, args)
+}
+
+// History runs historyQuery
+func (sr *SQLRepository) History(ctx context.Context, table statTable, columns, name string, snap snapshot, filters []filter) (Rows, error) {
+	query, args := historyQuery(sr.dialect, table.name, columns, name, snap, filters)
+	return sr.queryx(ctx, query, args)
+}
+
+// Range runs rangeQuery over every column of table
+func (sr *SQLRepository) Range(ctx context.Context, table statTable, team string, snap snapshot) (Rows, error) {
+	query, args := rangeQuery(sr.dialect, table.name, table.columns, team, snap)
+	return sr.queryx(ctx, query, args)
+}
+
+// Snapshots runs snapshotsQuery
+func (sr *SQLRepository) Snapshots(ctx context.Context, tables []string, team string) ([]Snapshot, error) {
+	snapshots := []Snapshot{}
+	query, args := snapshotsQuery(sr.dialect, tables, team)
+	err := sr.selectContext(ctx, &snapshots, query, args)
+	return snapshots, err
+}
+
+// LastModified runs lastModifiedQuery
+func (sr *SQLRepository) LastModified(ctx context.Context, table, team string, snap snapshot) (null.Time, error) {
+	var modified aggregateTime
+	query, args := lastModifiedQuery(sr.dialect, table, team, snap)
+	err := sr.Db.GetContext(ctx, &modified, sr.dialect.rebind(query), args...)
+	return modified.Time, err
+}
+
+// Leaders runs the leaderQuery of lb
+func (sr *SQLRepository) Leaders(ctx context.Context, lb leaderboard, p leaderParams, snap snapshot, filters []filter) (Rows, error) {
+	query, args := lb.leaderQuery(sr.dialect, p, snap, filters)
+	return sr.queryx(ctx, query, args)
+}
+
+// Audit runs the audit select built by f
+func (sr *SQLRepository) Audit(ctx context.Context, f auditFilter) ([]Audit, error) {
+	audits := []Audit{}
+	query, args := f.query(sr.dialect)
+	err := sr.selectContext(ctx, &audits, query, args)
+	return audits, err
+}
+
+// Freshness runs freshnessQuery
+func (sr *SQLRepository) Freshness(ctx context.Context, team string) ([]Freshness, error) {
+	var rows []struct {
+		Teamabbrev  string
+		Tablename   string
+		Lastsuccess aggregateTime
+		Lastfailure aggregateTime
+	}
+	query, args := freshnessQuery(sr.dialect, team)
+	if err := sr.selectContext(ctx, &rows, query, args); err != nil {
+		return nil, err
+	}
+	freshness := make([]Freshness, len(rows))
+	for i, row := range rows {
+		freshness[i] = Freshness{row.Teamabbrev, row.Tablename, row.Lastsuccess.Time, row.Lastfailure.Time}
+	}
+	return freshness, nil
+}
+
+// aggregateTimeLayouts are the layouts SQLite holds timestamps in: the one its driver binds time.Time values with
+// and the one CURRENT_TIMESTAMP writes
+var aggregateTimeLayouts = []string{"2006-01-02 15:04:05.999999999-07:00", "2006-01-02 15:04:05"}
+
+// aggregateTime scans an aggregate over a createddate column. SQLite only reports a column's declared type for
+// plain column references, so MAX(createddate) arrives as the stored text rather than a time.Time
+type aggregateTime struct {
+	null.Time
+}
+
+func (t *aggregateTime) Scan(value interface{}) error {
+	s, ok := value.(string)
+	if !ok {
+		return t.Time.Scan(value)
+	}
+	for _, layout := range aggregateTimeLayouts {
+		if parsed, err := time.Parse(layout, s); err == nil {
+			t.Time = null.TimeFrom(parsed)
+			return nil
+		}
+	}
+	return errors.Errorf("cannot parse %q as a timestamp", s)
+}
@@ -1,35 +1,50 @@
 package app
 
 import (
-	"log"
 	"net/http"
 	"time"
-	"sports-data-api/db"
 
 	"github.com/go-chi/chi"
 	"github.com/go-chi/chi/middleware"
+	"github.com/pkg/errors"
 )
 
 // Server represents a web server object
 type Server struct {
-	Dbc    *db.Container
+	Repo   StatsRepository
 	Router *chi.Mux
+	Cache  *Cache // optional response cache for the stat endpoints
+	teams  teamCache
 }
 
 // Routes
-func (s *Server) routes() {
+func (s *Server) routes() error {
+	schema, err := s.graphqlSchema()
+	if err != nil {
+		return errors.Wrap(err, "error building graphql schema")
+	}
+	s.Router.NotFound(notFound)
+	s.Router.MethodNotAllowed(methodNotAllowed)
 	s.Router.Use(
 		middleware.RedirectSlashes,
 		middleware.RequestID,
 		middleware.RealIP,
 		middleware.Logger,
 		middleware.Recoverer,
+		compressResponses(minCompressSize, compressibleTypes...),
 		middleware.Timeout(60 * time.Second),
 	)
+	s.Router.Get("/openapi.json", s.GetOpenAPI())
+	s.Router.Get("/docs", s.GetDocs())
 	s.Router.Route("/account", func(r chi.Router) { 
 		r.Use(middleware.Throttle(10))
 		r.Post("/generateToken", s.GenerateToken())						   // working
 	})
+	s.Router.Group(func(r chi.Router) {
+		r.Use(s.Authenticate)
+		r.Get("/graphql", s.GraphQL(schema))
+		r.Post("/graphql", s.GraphQL(schema))
+	})
 	s.Router.Route("/api/v1", func(r chi.Router) {
 		r.Use(s.Authenticate)
 		r.Route("/mlb", func(r chi.Router) {
@@ -44,12 +59,47 @@ func (s *Server) routes() {
 			r.Get("/splits/batting/{teamabbrev}", s.GetBattingSplits())    // working
 			r.Get("/splits/pitching", s.GetPitchingSplits())               // working
 			r.Get("/splits/pitching/{teamabbrev}", s.GetPitchingSplits())  // working
+			r.Get("/splits/homeaway/batting", s.GetBattingHomeAway())
+			r.Get("/splits/homeaway/batting/{teamabbrev}", s.GetBattingHomeAway())
+			r.Get("/splits/homeaway/pitching", s.GetPitchingHomeAway())
+			r.Get("/splits/homeaway/pitching/{teamabbrev}", s.GetPitchingHomeAway())
+			r.Get("/opponents/batting", s.GetBattingPitching())
+			r.Get("/opponents/batting/{teamabbrev}", s.GetBattingPitching())
+			r.Get("/snapshots", s.GetSnapshots())
+			r.Get("/snapshots/{table}", s.GetSnapshots())
+			r.Get("/snapshots/{table}/{teamabbrev}", s.GetSnapshots())
+			r.Get("/players/{name}/history", s.GetPlayerHistory())
+			r.Get("/batting/{teamabbrev}/diff", s.GetDiff("batting"))
+			r.Get("/pitching/{teamabbrev}/diff", s.GetDiff("pitching"))
+			r.Get("/baserunning/{teamabbrev}/diff", s.GetDiff("baserunning"))
+			r.Get("/leaders/batting", s.GetLeaders("batting"))
+			r.Get("/leaders/pitching", s.GetLeaders("pitching"))
+			r.Get("/freshness", s.GetFreshness())
+			r.Get("/freshness/{teamabbrev}", s.GetFreshness())
+			r.Get("/export/{table}", s.GetExport())
+		})
+		r.Route("/admin", func(r chi.Router) {
+			r.Get("/audit", s.GetAudit())
+			r.Get("/cache", s.GetCacheStats())
 		})
 	})
+	return nil
+}
+
+// Handler initializes routes and returns the router, for serving the REST API without Start
+func (s *Server) Handler() (http.Handler, error) {
+	if err := s.routes(); err != nil {
+		return nil, err
+	}
+	return s.Router, nil
 }
 
-// Start initializes routes and starts server
-func (s *Server) Start() {
-	s.routes()
-	log.Fatal(http.ListenAndServe(":8600", s.Router))
+// Start initializes routes and starts server; the gRPC service listens on its own port
+func (s *Server) Start() error {
+	handler, err := s.Handler()
+	if err != nil {
+		return err
+	}
+	go s.serveGRPC(":8601")
+	return http.ListenAndServe(":8600", handler)
 }
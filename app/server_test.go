@@ -0,0 +1,408 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"sports-data-api/db"
+
+	"github.com/go-chi/chi"
+	"github.com/jmoiron/sqlx"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const fixtures = "../fixtures"
+
+// testServer serves a repository through the full router with a token for the fixture user
+type testServer struct {
+	t     *testing.T
+	url   string
+	token string
+}
+
+func newTestServer(t *testing.T, repo StatsRepository) *testServer {
+	t.Helper()
+	return serveTestServer(t, &Server{Repo: repo, Router: chi.NewRouter()})
+}
+
+// serveTestServer serves a configured server, such as one with a Cache, through its full router
+func serveTestServer(t *testing.T, s *Server) *testServer {
+	t.Helper()
+	if err := s.routes(); err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(s.Router)
+	t.Cleanup(srv.Close)
+	ts := &testServer{t: t, url: srv.URL}
+	resp, body := ts.do(http.MethodPost, "/account/generateToken", `{"username":"developer","password":"developer"}`)
+	var token JwtToken
+	if err := json.Unmarshal(body, &token); err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("generateToken: %d %s", resp.StatusCode, body)
+	}
+	ts.token = token.Token
+	return ts
+}
+
+// fixtureRepository loads the fixtures into a MemoryRepository
+func fixtureRepository(t *testing.T) *MemoryRepository {
+	t.Helper()
+	repo, err := LoadMemoryRepository(fixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return repo
+}
+
+// sqliteRepository loads the fixtures into a migrated SQLite database in place of the seeded teams, so the SQL
+// the dialects render is run against the same rows the MemoryRepository holds
+func sqliteRepository(t *testing.T) *SQLRepository {
+	t.Helper()
+	dbc := &db.Container{Conf: db.Dbconfig{Rdbms: "sqlite", Database: filepath.Join(t.TempDir(), "sda.db")}}
+	if err := dbc.Open(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { dbc.Close() })
+	return fixtureDatabase(t, dbc)
+}
+
+// fixtureDatabase migrates an open database and loads the fixtures in place of the seeded teams, each table in a
+// transaction of its own; SQL Server only accepts the fixture ids with IDENTITY_INSERT on for the table
+func fixtureDatabase(t *testing.T, dbc *db.Container) *SQLRepository {
+	t.Helper()
+	rdbms := dbc.Conf.Rdbms
+	if _, err := dbc.MigrateUp(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dbc.Db.Exec("DELETE FROM " + db.Schema(rdbms) + "team"); err != nil {
+		t.Fatal(err)
+	}
+	teamids := map[string]interface{}{}
+	for _, name := range append([]string{"team", "users", "audit"}, snapshotTables...) {
+		b, err := os.ReadFile(filepath.Join(fixtures, name+".json"))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		var rows []map[string]interface{}
+		if err := json.Unmarshal(b, &rows); err != nil {
+			t.Fatalf("%s fixture: %v", name, err)
+		}
+		table := db.Schema(rdbms) + name
+		if name == "users" {
+			table = "users"
+			if rdbms == "sqlserver" {
+				table = "basic_auth.users"
+			}
+		}
+		tx, err := dbc.Db.Beginx()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rdbms == "sqlserver" && name != "users" {
+			if _, err := tx.Exec("SET IDENTITY_INSERT " + table + " ON"); err != nil {
+				t.Fatalf("%s fixture: %v", name, err)
+			}
+		}
+		for _, row := range rows {
+			switch {
+			case name == "team":
+				teamids[row["teamabbrev"].(string)] = row["id"]
+			case name == "users":
+				hash, err := bcrypt.GenerateFromPassword([]byte(row["password"].(string)), bcrypt.MinCost)
+				if err != nil {
+					t.Fatal(err)
+				}
+				row = map[string]interface{}{"email": row["username"], "pass": string(hash), "role": row["role"]}
+			default:
+				if abbrev, ok := row["teamabbrev"].(string); ok {
+					row["teamid"] = teamids[abbrev]
+				}
+				delete(row, "teamabbrev")
+			}
+			if s, ok := row["createddate"].(string); ok {
+				if row["createddate"], err = time.Parse(time.RFC3339, s); err != nil {
+					t.Fatal(err)
+				}
+			}
+			columns := make([]string, 0, len(row))
+			for column := range row {
+				columns = append(columns, column)
+			}
+			sort.Strings(columns)
+			args := make([]interface{}, len(columns))
+			for i, column := range columns {
+				args[i] = row[column]
+			}
+			query := "INSERT INTO " + table + " (" + strings.Join(columns, ", ") + ") VALUES (" +
+				strings.TrimSuffix(strings.Repeat("?, ", len(columns)), ", ") + ")"
+			if _, err := tx.Exec(tx.Rebind(query), args...); err != nil {
+				t.Fatalf("%s fixture: %v", name, err)
+			}
+		}
+		if rdbms == "sqlserver" && name != "users" {
+			if _, err := tx.Exec("SET IDENTITY_INSERT " + table + " OFF"); err != nil {
+				t.Fatalf("%s fixture: %v", name, err)
+			}
+		}
+		if err := tx.Commit(); err != nil {
+			t.Fatalf("%s fixture: %v", name, err)
+		}
+	}
+	repo, err := NewSQLRepository(dbc.Db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return repo
+}
+
+// testServers serves the fixtures from the MemoryRepository and from SQLite, keyed by backend
+func testServers(t *testing.T) map[string]*testServer {
+	return map[string]*testServer{
+		"memory": newTestServer(t, fixtureRepository(t)),
+		"sqlite": newTestServer(t, sqliteRepository(t)),
+	}
+}
+
+// do sends a request with the token, if any, and an optional JSON body, followed by header name and value pairs
+func (ts *testServer) do(method, path, body string, header ...string) (*http.Response, []byte) {
+	ts.t.Helper()
+	var r io.Reader
+	if body != "" {
+		r = strings.NewReader(body)
+	}
+	req, err := http.NewRequest(method, ts.url+path, r)
+	if err != nil {
+		ts.t.Fatal(err)
+	}
+	if ts.token != "" {
+		req.Header.Set("Authorization", "Bearer "+ts.token)
+	}
+	for i := 0; i+1 < len(header); i += 2 {
+		req.Header.Set(header[i], header[i+1])
+	}
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	if err != nil {
+		ts.t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		ts.t.Fatal(err)
+	}
+	return resp, b
+}
+
+// get requests path and decodes a JSON response into v, failing unless the status is want
+func (ts *testServer) get(path string, want int, v interface{}) *http.Response {
+	ts.t.Helper()
+	resp, body := ts.do(http.MethodGet, path, "")
+	if resp.StatusCode != want {
+		ts.t.Fatalf("GET %s: status %d, want %d: %s", path, resp.StatusCode, want, body)
+	}
+	if v != nil {
+		if err := json.Unmarshal(body, v); err != nil {
+			ts.t.Fatalf("GET %s: %v: %s", path, err, body)
+		}
+	}
+	return resp
+}
+
+// problem requests path and returns the problem response, failing unless the status is want
+func (ts *testServer) problem(path string, want int) Problem {
+	ts.t.Helper()
+	var p Problem
+	resp := ts.get(path, want, &p)
+	if ct := resp.Header.Get("Content-Type"); ct != problemContentType {
+		ts.t.Fatalf("GET %s: content type %q, want %q", path, ct, problemContentType)
+	}
+	return p
+}
+
+func TestAuthentication(t *testing.T) {
+	ts := newTestServer(t, fixtureRepository(t))
+	anonymous := &testServer{t: t, url: ts.url}
+	resp, _ := anonymous.do(http.MethodPost, "/account/generateToken", `{"username":"developer","password":"wrong"}`)
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("bad credentials: status %d, want 401", resp.StatusCode)
+	}
+	resp, _ = anonymous.do(http.MethodPost, "/account/generateToken", `{"username":"developer","extra":1}`)
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("unknown body field: status %d, want 400", resp.StatusCode)
+	}
+	if p := anonymous.problem("/api/v1/mlb/teams", http.StatusUnauthorized); p.Code != codeMissingToken {
+		t.Errorf("no token: code %q, want %q", p.Code, codeMissingToken)
+	}
+	forged := &testServer{t: t, url: ts.url, token: ts.token + "x"}
+	if p := forged.problem("/api/v1/mlb/teams", http.StatusUnauthorized); p.Code != codeInvalidToken {
+		t.Errorf("forged token: code %q, want %q", p.Code, codeInvalidToken)
+	}
+	var teams []Team
+	ts.get("/api/v1/mlb/teams", http.StatusOK, &teams)
+	if len(teams) != 2 {
+		t.Errorf("got %d teams, want 2", len(teams))
+	}
+}
+
+func TestRouting(t *testing.T) {
+	ts := newTestServer(t, fixtureRepository(t))
+	tests := []struct {
+		path   string
+		status int
+		code   string
+	}{
+		{"/api/v1/mlb/nothing", http.StatusNotFound, codeNotFound},
+		{"/api/v1/mlb/batting/XXX", http.StatusNotFound, codeUnknownTeam},
+		{"/api/v1/admin/audit?team=XXX", http.StatusNotFound, codeUnknownTeam},
+		{"/api/v1/mlb/snapshots/fielding", http.StatusNotFound, codeUnknownTable},
+		{"/api/v1/mlb/batting?format=xml", http.StatusBadRequest, codeInvalidParameter},
+	}
+	for _, tt := range tests {
+		resp, body := ts.do(http.MethodGet, tt.path, "")
+		var p Problem
+		if err := json.Unmarshal(body, &p); err != nil || resp.StatusCode != tt.status || p.Code != tt.code {
+			t.Errorf("GET %s: %d %s, want %d %s", tt.path, resp.StatusCode, body, tt.status, tt.code)
+		}
+	}
+	resp, _ := ts.do(http.MethodDelete, "/api/v1/mlb/teams", "")
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("DELETE teams: status %d, want 405", resp.StatusCode)
+	}
+}
+
+func TestEncoding(t *testing.T) {
+	ts := newTestServer(t, fixtureRepository(t))
+	tests := []struct {
+		path, accept, contentType, body string
+	}{
+		{"/api/v1/mlb/batting/NYY?fields=name,hr", "", "application/json", `[{"name":"Aaron Judge","hr":`},
+		{"/api/v1/mlb/batting/NYY?fields=name,hr&format=csv", "", "text/csv", "name,hr\nAaron Judge,"},
+		{"/api/v1/mlb/batting/NYY?fields=name,hr", "text/tab-separated-values", "text/tab-separated-values", "name\thr\nAaron Judge\t"},
+		{"/api/v1/mlb/batting/NYY?fields=name,hr&format=ndjson", "", "application/x-ndjson", `{"name":"Aaron Judge","hr":`},
+	}
+	for _, tt := range tests {
+		resp, body := ts.do(http.MethodGet, tt.path, "", "Accept", tt.accept)
+		if resp.StatusCode != http.StatusOK || !strings.HasPrefix(resp.Header.Get("Content-Type"), tt.contentType) || !strings.HasPrefix(string(body), tt.body) {
+			t.Errorf("GET %s (%s): %d %s %q, want %s %q", tt.path, tt.accept, resp.StatusCode, resp.Header.Get("Content-Type"), body, tt.contentType, tt.body)
+		}
+	}
+	resp, _ := ts.do(http.MethodGet, "/api/v1/mlb/batting", "")
+	etag := resp.Header.Get("ETag")
+	if etag == "" {
+		t.Fatal("no ETag on a stat response")
+	}
+	if resp, _ = ts.do(http.MethodGet, "/api/v1/mlb/batting", "", "If-None-Match", etag); resp.StatusCode != http.StatusNotModified {
+		t.Errorf("If-None-Match: status %d, want 304", resp.StatusCode)
+	}
+}
+
+// matchedPaths are the requests every SQL backend must answer as the MemoryRepository does
+var matchedPaths = []string{
+	"/api/v1/mlb/teams",
+	"/api/v1/mlb/batting",
+	"/api/v1/mlb/batting/nyy?fields=id,name,hr",
+	"/api/v1/mlb/pitching",
+	"/api/v1/mlb/batting?hr[gte]=10&fields=name,hr",
+	"/api/v1/mlb/batting?hr[in]=10,4&fields=name,hr",
+	"/api/v1/mlb/batting?name[like]=%25JUDGE&fields=name",
+	"/api/v1/mlb/batting?fields=id,name&limit=2",
+	"/api/v1/mlb/batting?limit=2&fields=name,ops&format=csv",
+	"/api/v1/mlb/batting/NYY?asof=2020-08-02&fields=id,hr&format=ndjson",
+	"/api/v1/mlb/leaders/batting?limit=2",
+	"/api/v1/mlb/leaders/batting?sort=-sf&limit=2",
+	"/api/v1/mlb/leaders/pitching?min_ip=30",
+	"/api/v1/mlb/players/Mitch%20Moreland/history?fields=name,hr",
+	"/api/v1/mlb/snapshots",
+	"/api/v1/mlb/snapshots/batting",
+	"/api/v1/mlb/batting/NYY/diff?from=2020-08-02",
+	"/api/v1/mlb/freshness",
+	"/api/v1/admin/audit?status=table_timeout",
+	"/api/v1/admin/audit?team=nyy",
+	"/api/v1/admin/audit?from=2020-08-01&to=2020-08-01",
+	"/api/v1/mlb/export/batting?format=arrow&from=2020-08-01",
+}
+
+// matchesMemory compares the answers of a SQL backend to matchedPaths with the MemoryRepository's
+func matchesMemory(t *testing.T, name string, memory, sql *testServer) {
+	t.Helper()
+	for _, path := range matchedPaths {
+		memoryResp, memoryBody := memory.do(http.MethodGet, path, "")
+		sqlResp, sqlBody := sql.do(http.MethodGet, path, "")
+		if memoryResp.StatusCode != sqlResp.StatusCode || string(memoryBody) != string(sqlBody) {
+			t.Errorf("GET %s\nmemory %d %s\n%s %d %s", path, memoryResp.StatusCode, memoryBody, name, sqlResp.StatusCode, sqlBody)
+		}
+	}
+}
+
+// TestSQLiteMatchesMemory checks that the MemoryRepository answers as the SQL does, so handler tests against the
+// fixtures stand for the database
+func TestSQLiteMatchesMemory(t *testing.T) {
+	servers := testServers(t)
+	matchesMemory(t, "sqlite", servers["memory"], servers["sqlite"])
+}
+
+func TestRepositoryWithoutConnection(t *testing.T) {
+	if repo, err := NewSQLRepository(nil); err == nil || repo != nil {
+		t.Errorf("NewSQLRepository(nil): %v %v, want it rejected", repo, err)
+	}
+	if _, err := NewSQLRepository(sqlx.NewDb(nil, "oracle")); err == nil {
+		t.Error("NewSQLRepository on oracle: want the driver rejected")
+	}
+}
+
+func TestRepositoryWithoutLoadRuns(t *testing.T) {
+	dbc := &db.Container{Conf: db.Dbconfig{Rdbms: "sqlite", Database: filepath.Join(t.TempDir(), "sda.db")}}
+	if err := dbc.Open(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { dbc.Close() })
+	if _, err := dbc.MigrateUp(); err != nil {
+		t.Fatal(err)
+	}
+	repo, err := NewSQLRepository(dbc.Db)
+	if err != nil || !repo.dialect.runs {
+		t.Fatalf("NewSQLRepository after 0004_load_runs: runs %v %v, want unfinished runs hidden", repo != nil && repo.dialect.runs, err)
+	}
+	if _, _, err := dbc.MigrateDown(); err != nil {
+		t.Fatal(err)
+	}
+	if repo, err = NewSQLRepository(dbc.Db); err != nil || repo.dialect.runs {
+		t.Fatalf("NewSQLRepository without load_run: runs %v %v, want the rows served as written", repo != nil && repo.dialect.runs, err)
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte("developer"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dbc.Db.Exec("INSERT INTO users (email, pass, role) VALUES ('developer', ?, 'api')", string(hash)); err != nil {
+		t.Fatal(err)
+	}
+	resp, body := newTestServer(t, repo).do(http.MethodGet, "/api/v1/mlb/batting/NYY", "")
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("GET /batting/NYY without load_run: %d %s", resp.StatusCode, body)
+	}
+}
+
+func TestUserRoleCheckedInGo(t *testing.T) {
+	repo := sqliteRepository(t)
+	repo.dialect.userPass = mysqlDialect.userPass
+	ctx := context.Background()
+	for _, tt := range []struct{ username, password, want string }{
+		{"developer", "developer", "api_user"},
+		{"developer", "wrong", ""},
+		{"nobody", "developer", ""},
+	} {
+		if role, err := repo.UserRole(ctx, tt.username, tt.password); err != nil || role != tt.want {
+			t.Errorf("UserRole(%q, %q) = %q, %v, want %q", tt.username, tt.password, role, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package app
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v3"
+)
+
+// snapshot bounds the createddate values considered when ranking rows; zero values are unbounded
+type snapshot struct {
+	from time.Time
+	to   time.Time
+}
+
+const dateLayout = "2006-01-02"
+
+// snapshotTables lists the baseballreference tables that hold createddate snapshots
+var snapshotTables = []string{
+	"batting", "pitching", "batting_splits", "pitching_splits", "baserunning",
+	"batting_pitching", "batting_home_away", "pitching_home_away",
+}
+
+var errAsofAndSnapshot = errors.New("asof and snapshot parameters cannot be combined")
+
+// parseSnapshot reads the asof and snapshot query parameters; asof selects the snapshot that was current
+// at the given instant (a bare date means end of day) and snapshot selects an exact createddate (or day)
+func parseSnapshot(r *http.Request) (snapshot, error) {
+	return newSnapshot(r.URL.Query().Get("asof"), r.URL.Query().Get("snapshot"))
+}
+
+// newSnapshot builds the createddate bounds for an asof instant or an exact snapshot; both empty means the latest
+func newSnapshot(asof, exact string) (snap snapshot, err error) {
+	switch {
+	case asof != "" && exact != "":
+		err = errAsofAndSnapshot
+	case asof != "":
+		var day bool
+		snap.to, day, err = parseTimeParam(asof)
+		if err != nil {
+			err = errors.Wrap(err, "invalid asof parameter")
+			return
+		}
+		if day {
+			snap.to = endOfDay(snap.to)
+		}
+	case exact != "":
+		var day bool
+		snap.from, day, err = parseTimeParam(exact)
+		if err != nil {
+			err = errors.Wrap(err, "invalid snapshot parameter")
+			return
+		}
+		snap.to = snap.from
+		if day {
+			snap.to = endOfDay(snap.from)
+		}
+	}
+	return
+}
+
+// parseTimeParam accepts RFC 3339 timestamps, Postgres-style timestamps or bare dates; day reports a bare date
+func parseTimeParam(value string) (t time.Time, day bool, err error) {
+	if t, err = time.Parse(dateLayout, value); err == nil {
+		return t, true, nil
+	}
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999", "2006-01-02 15:04:05.999999999"} {
+		if t, err = time.Parse(layout, value); err == nil {
+			return t, false, nil
+		}
+	}
+	return time.Time{}, false, fmt.Errorf("%q is not a date (YYYY-MM-DD) or timestamp (RFC 3339)", value)
+}
+
+// conditions appends the bounds of snap on a createddate column to a where clause
+func (snap snapshot) conditions(column string, where []string, args []interface{}) ([]string, []interface{}) {
+	if !snap.from.IsZero() {
+		args = append(args, snap.from)
+		where = append(where, column+" >= ?")
+	}
+	if !snap.to.IsZero() {
+		args = append(args, snap.to)
+		where = append(where, column+" <= ?")
+	}
+	return where, args
+}
+
+// newest returns the newest createddate of snapshots within snap; null when none is
+func (snap snapshot) newest(snapshots []Snapshot) (newest null.Time) {
+	for _, s := range snapshots {
+		t := s.Createddate
+		if !t.Valid || (!snap.from.IsZero() && t.Time.Before(snap.from)) || (!snap.to.IsZero() && t.Time.After(snap.to)) {
+			continue
+		}
+		if !newest.Valid || t.Time.After(newest.Time) {
+			newest = t
+		}
+	}
+	return
+}
+
+// parseRange reads the from and to query parameters as inclusive createddate bounds
+func parseRange(r *http.Request) (snapshot, error) {
+	return newRange(r.URL.Query().Get("from"), r.URL.Query().Get("to"))
+}
+
+// newRange builds inclusive createddate bounds; a bare to date means end of day and empty values are unbounded. A
+// from after to is rejected rather than matching nothing
+func newRange(from, to string) (snap snapshot, err error) {
+	var day bool
+	if from != "" {
+		if snap.from, _, err = parseTimeParam(from); err != nil {
+			err = errors.Wrap(err, "invalid from parameter")
+			return
+		}
+	}
+	if to != "" {
+		if snap.to, day, err = parseTimeParam(to); err != nil {
+			err = errors.Wrap(err, "invalid to parameter")
+			return
+		}
+		if day {
+			snap.to = endOfDay(snap.to)
+		}
+	}
+	if !snap.from.IsZero() && !snap.to.IsZero() && snap.from.After(snap.to) {
+		err = errors.New("from must not be after to")
+	}
+	return
+}
+
+func endOfDay(t time.Time) time.Time {
+	return t.AddDate(0, 0, 1).Add(-time.Microsecond)
+}
+
+func isSnapshotTable(table string) bool {
+	for _, t := range snapshotTables {
+		if t == table {
+			return true
+		}
+	}
+	return false
+}
+
+// snapshotsQuery builds a union over the requested tables listing each distinct createddate per team
+func snapshotsQuery(d dialect, tables []string, team string) (string, []interface{}) {
+	var (
+		args        []interface{}
+		where       []string
+		whereClause string
+	)
+	if team != "" {
+		where = append(where, "t.teamabbrev = ?")
+	}
+	if where = d.visible(where); len(where) > 0 {
+		whereClause = "WHERE	" + strings.Join(where, "\n\t\t\tAND\t")
+	}
+	selects := make([]string, 0, len(tables))
+	for _, table := range tables {
+		if team != "" {
+			args = append(args, team) // each branch of the union binds its own placeholder
+		}
+		selects = append(selects, fmt.Sprintf(
+			`SELECT	'%[1]s' AS tablename, t.teamabbrev, p.createddate, COUNT(*) AS rowcount
+			FROM	%[2]s p
+					INNER JOIN %[3]s t ON t.id = p.teamid
+			%[4]s
+			GROUP BY t.teamabbrev, p.createddate`,
+			table, d.table(table), d.table("team"), whereClause,
+		))
+	}
+	query := strings.Join(selects, "\n\t\t\tUNION ALL\n\t\t\t") + "\n\t\t\tORDER BY tablename, teamabbrev, createddate DESC"
+	return query, args
+}
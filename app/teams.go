@@ -0,0 +1,109 @@
+package app
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// teamRefreshInterval is how long the cached team list is trusted before it is reloaded
+const teamRefreshInterval = 10 * time.Minute
+
+// teamAliases maps alternate and historic franchise codes to Baseball-Reference abbreviations; an alias is only
+// used when the requested code is not itself in baseballreference.team, and is followed on through the aliases of
+// the code it names, so a historic code reaches whichever abbreviation the table holds
+var teamAliases = map[string]string{
+	"ANA": "LAA", // Anaheim Angels
+	"CAL": "LAA", // California Angels
+	"CWS": "CHW",
+	"KC":  "KCR",
+	"SD":  "SDP",
+	"SF":  "SFG",
+	"TB":  "TBR",
+	"TBD": "TBR", // Tampa Bay Devil Rays
+	"WAS": "WSN",
+	"WSH": "WSN",
+	"MON": "WSN", // Montreal Expos
+	"FLA": "MIA", // Florida Marlins
+	"ATH": "OAK", // Athletics
+	"OAK": "ATH",
+	"BRO": "LAD", // Brooklyn Dodgers
+	"NYG": "SFG", // New York Giants
+	"BSN": "ATL", // Boston Braves
+	"MLN": "ATL", // Milwaukee Braves
+	"SLB": "BAL", // St. Louis Browns
+	"PHA": "OAK", // Philadelphia Athletics
+	"SEP": "MIL", // Seattle Pilots
+	"WSA": "TEX", // Washington Senators (1961-1971)
+}
+
+// teamCache holds the abbreviations of every team in the repository
+type teamCache struct {
+	sync.RWMutex
+	codes  map[string]bool
+	sorted []string
+	loaded time.Time
+}
+
+// teamCodes returns the cached team abbreviations, reloading them once they are older than teamRefreshInterval; a
+// failed reload keeps serving the previous list
+func (s *Server) teamCodes() (map[string]bool, []string, error) {
+	s.teams.RLock()
+	codes, sorted, loaded := s.teams.codes, s.teams.sorted, s.teams.loaded
+	s.teams.RUnlock()
+	if codes != nil && time.Since(loaded) < teamRefreshInterval {
+		return codes, sorted, nil
+	}
+	s.teams.Lock()
+	defer s.teams.Unlock()
+	if s.teams.codes != nil && time.Since(s.teams.loaded) < teamRefreshInterval {
+		return s.teams.codes, s.teams.sorted, nil
+	}
+	teams, err := s.Repo.Teams(context.Background())
+	if err != nil {
+		if s.teams.codes != nil {
+			log.Println(errors.Wrap(err, "error refreshing teams; serving cached list"))
+			return s.teams.codes, s.teams.sorted, nil
+		}
+		return nil, nil, err
+	}
+	s.teams.codes = make(map[string]bool, len(teams))
+	s.teams.sorted = make([]string, len(teams))
+	for i, t := range teams {
+		s.teams.codes[t.TeamAbbrev] = true
+		s.teams.sorted[i] = t.TeamAbbrev
+	}
+	s.teams.loaded = time.Now()
+	return s.teams.codes, s.teams.sorted, nil
+}
+
+// resolveTeam maps a requested team abbreviation, in any case or as an alias, to the code stored in
+// baseballreference.team; an empty abbreviation stays empty. Unknown codes are a 404 listing the valid codes
+func (s *Server) resolveTeam(abbrev string) (string, error) {
+	if abbrev == "" {
+		return "", nil
+	}
+	codes, sorted, err := s.teamCodes()
+	if err != nil {
+		return "", newAPIError(http.StatusInternalServerError, codeDatabase, err)
+	}
+	code := strings.ToUpper(strings.TrimSpace(abbrev))
+	if codes[code] {
+		return code, nil
+	}
+	seen := map[string]bool{code: true}
+	for alias, ok := teamAliases[code]; ok && !seen[alias]; alias, ok = teamAliases[alias] {
+		if codes[alias] {
+			return alias, nil
+		}
+		seen[alias] = true
+	}
+	e := newAPIError(http.StatusNotFound, codeUnknownTeam, errors.Errorf("unknown team: %s", abbrev))
+	e.valid = sorted
+	return "", e
+}
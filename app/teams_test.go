@@ -0,0 +1,51 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+// teamsRepository serves a fixed team list
+type teamsRepository struct {
+	StatsRepository
+	teams []Team
+}
+
+func (tr teamsRepository) Teams(ctx context.Context) ([]Team, error) {
+	return tr.teams, nil
+}
+
+func TestResolveTeam(t *testing.T) {
+	tests := []struct {
+		held   []string
+		abbrev string
+		want   string
+		status int
+	}{
+		{[]string{"BOS", "NYY"}, "nyy", "NYY", 0},
+		{[]string{"BOS", "NYY"}, " Bos ", "BOS", 0},
+		{[]string{"BOS", "NYY"}, "", "", 0},
+		{[]string{"BOS", "NYY"}, "XYZ", "", http.StatusNotFound},
+		{[]string{"CHW"}, "CWS", "CHW", 0},
+		{[]string{"ATH"}, "OAK", "ATH", 0},
+		{[]string{"OAK"}, "ATH", "OAK", 0},
+		{[]string{"ATH"}, "PHA", "ATH", 0},
+		{[]string{"OAK"}, "PHA", "OAK", 0},
+		{[]string{"BOS"}, "PHA", "", http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		repo := teamsRepository{}
+		for _, abbrev := range tt.held {
+			repo.teams = append(repo.teams, Team{TeamAbbrev: abbrev})
+		}
+		got, err := (&Server{Repo: repo}).resolveTeam(tt.abbrev)
+		var status int
+		if e, ok := err.(*apiError); ok {
+			status = e.status
+		}
+		if status != tt.status || got != tt.want {
+			t.Errorf("resolveTeam(%q) with %v: %q %v, want %q status %d", tt.abbrev, tt.held, got, err, tt.want, tt.status)
+		}
+	}
+}
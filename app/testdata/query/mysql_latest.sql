@@ -0,0 +1,10 @@
+SELECT	id, name
+		FROM 	(
+					SELECT	p.*, t.teamabbrev, DENSE_RANK() OVER(PARTITION BY teamid ORDER BY createddate DESC) AS rnk
+					FROM	batting p
+							INNER JOIN team t ON t.id = p.teamid
+					WHERE	t.teamabbrev = ?
+				) x
+		WHERE	rnk = 1
+		ORDER BY x.teamid, x.id
+-- args: [NYY]
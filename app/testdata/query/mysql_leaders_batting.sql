@@ -0,0 +1,21 @@
+SELECT	*
+		FROM	(
+					SELECT	l.*, RANK() OVER(ORDER BY l.ops DESC) AS leaguerank
+					FROM	(SELECT	id, teamabbrev, rk, pos, name, age, g, pa, ab, r, h, twob, threeb, hr, rbi,
+		sb, cs, bb, so, ba, obp, slg, ops, opsplus, tb, gdp, hbp, sh, sf, ibb, createddate
+		FROM 	(
+					SELECT	p.*, t.teamabbrev, DENSE_RANK() OVER(PARTITION BY teamid ORDER BY createddate DESC) AS rnk
+					FROM	batting p
+							INNER JOIN team t ON t.id = p.teamid
+					WHERE	p.createddate >= ?
+					AND	p.createddate <= ?
+				) x
+		WHERE	rnk = 1
+		AND	x.pos IN (?, ?)
+		) l
+					WHERE	l.ops IS NOT NULL
+					AND		COALESCE(l.pa, 0) >= ?
+				) ranked
+		WHERE	leaguerank <= ?
+		ORDER BY leaguerank, name
+-- args: [2020-08-08 06:00:00 +0000 UTC 2020-08-08 06:00:00 +0000 UTC 1B RF 100 10]
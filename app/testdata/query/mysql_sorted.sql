@@ -0,0 +1,13 @@
+SELECT	id, name, hr
+		FROM 	(
+					SELECT	p.*, t.teamabbrev, DENSE_RANK() OVER(PARTITION BY teamid ORDER BY createddate DESC) AS rnk
+					FROM	batting p
+							INNER JOIN team t ON t.id = p.teamid
+					WHERE	p.createddate <= ?
+				) x
+		WHERE	rnk = 1
+		AND	x.hr >= ?
+		AND	x.name LIKE ?
+		ORDER BY CASE WHEN x.hr IS NULL THEN 1 ELSE 0 END, x.hr DESC, x.teamid, x.id
+		LIMIT ?
+-- args: [2020-08-02 23:59:59 +0000 UTC 10 %judge 5]
@@ -0,0 +1,22 @@
+SELECT	*
+		FROM	(
+					SELECT	l.*, RANK() OVER(ORDER BY l.ops DESC) AS leaguerank
+					FROM	(SELECT	id, teamabbrev, rk, pos, name, age, g, pa, ab, r, h, twob, threeb, hr, rbi,
+		sb, cs, bb, so, ba, obp, slg, ops, opsplus, tb, gdp, hbp, sh, sf, ibb, createddate
+		FROM 	(
+					SELECT	p.*, t.teamabbrev, DENSE_RANK() OVER(PARTITION BY teamid ORDER BY createddate DESC) AS rnk
+					FROM	baseballreference.batting p
+							INNER JOIN baseballreference.team t ON t.id = p.teamid
+					WHERE	p.createddate >= $1
+					AND	p.createddate <= $2
+					AND	NOT EXISTS (SELECT 1 FROM baseballreference.load_run r WHERE r.id = p.runid AND r.completedat IS NULL)
+				) x
+		WHERE	rnk = 1
+		AND	x.pos IN ($3, $4)
+		) l
+					WHERE	l.ops IS NOT NULL
+					AND		COALESCE(l.pa, 0) >= $5
+				) ranked
+		WHERE	leaguerank <= $6
+		ORDER BY leaguerank, name
+-- args: [2020-08-08 06:00:00 +0000 UTC 2020-08-08 06:00:00 +0000 UTC 1B RF 100 10]
@@ -0,0 +1,12 @@
+SELECT	id, name, teamid
+		FROM 	(
+					SELECT	p.*, t.teamabbrev, DENSE_RANK() OVER(PARTITION BY teamid ORDER BY createddate DESC) AS rnk
+					FROM	baseballreference.batting p
+							INNER JOIN baseballreference.team t ON t.id = p.teamid
+					WHERE	NOT EXISTS (SELECT 1 FROM baseballreference.load_run r WHERE r.id = p.runid AND r.completedat IS NULL)
+				) x
+		WHERE	rnk = 1
+		AND	(x.teamid, x.id) < ($1, $2)
+		ORDER BY x.teamid DESC, x.id DESC
+		LIMIT $3
+-- args: [1 2 3]
@@ -0,0 +1,14 @@
+SELECT	id, name, hr
+		FROM 	(
+					SELECT	p.*, t.teamabbrev, DENSE_RANK() OVER(PARTITION BY teamid ORDER BY createddate DESC) AS rnk
+					FROM	baseballreference.batting p
+							INNER JOIN baseballreference.team t ON t.id = p.teamid
+					WHERE	p.createddate <= $1
+					AND	NOT EXISTS (SELECT 1 FROM baseballreference.load_run r WHERE r.id = p.runid AND r.completedat IS NULL)
+				) x
+		WHERE	rnk = 1
+		AND	x.hr >= $2
+		AND	x.name ILIKE $3
+		ORDER BY x.hr DESC NULLS LAST, x.teamid, x.id
+		LIMIT $4
+-- args: [2020-08-02 23:59:59 +0000 UTC 10 %judge 5]
@@ -0,0 +1,11 @@
+SELECT	id, name
+		FROM 	(
+					SELECT	p.*, t.teamabbrev, DENSE_RANK() OVER(PARTITION BY teamid ORDER BY createddate DESC) AS rnk
+					FROM	batting p
+							INNER JOIN team t ON t.id = p.teamid
+					WHERE	t.teamabbrev = ?
+					AND	NOT EXISTS (SELECT 1 FROM load_run r WHERE r.id = p.runid AND r.completedat IS NULL)
+				) x
+		WHERE	rnk = 1
+		ORDER BY x.teamid, x.id
+-- args: [NYY]
@@ -0,0 +1,19 @@
+SELECT	*
+		FROM	(
+					SELECT	l.*, RANK() OVER(ORDER BY l.era ASC) AS leaguerank
+					FROM	(SELECT	id, teamabbrev, rk, pos, name, age, w, l, wl, era, g, gs, gf, cg, sho, sv, ip, h, r,
+		er, hr, bb, ibb, so, hbp, bk, wp, bf, eraplus, fip, whip, h9, hr9, bb9, so9, sow, createddate
+		FROM 	(
+					SELECT	p.*, t.teamabbrev, DENSE_RANK() OVER(PARTITION BY teamid ORDER BY createddate DESC) AS rnk
+					FROM	pitching p
+							INNER JOIN team t ON t.id = p.teamid
+					WHERE	NOT EXISTS (SELECT 1 FROM load_run r WHERE r.id = p.runid AND r.completedat IS NULL)
+				) x
+		WHERE	rnk = 1
+		) l
+					WHERE	l.era IS NOT NULL
+					AND		COALESCE(l.ip, 0) >= ?
+				) ranked
+		WHERE	leaguerank <= ?
+		ORDER BY leaguerank, name
+-- args: [0 25]
@@ -0,0 +1,12 @@
+SELECT	id, name, teamid
+		FROM 	(
+					SELECT	p.*, t.teamabbrev, DENSE_RANK() OVER(PARTITION BY teamid ORDER BY createddate DESC) AS rnk
+					FROM	batting p
+							INNER JOIN team t ON t.id = p.teamid
+					WHERE	NOT EXISTS (SELECT 1 FROM load_run r WHERE r.id = p.runid AND r.completedat IS NULL)
+				) x
+		WHERE	rnk = 1
+		AND	(x.teamid, x.id) < (?, ?)
+		ORDER BY x.teamid DESC, x.id DESC
+		LIMIT ?
+-- args: [1 2 3]
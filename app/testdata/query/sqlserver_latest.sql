@@ -0,0 +1,10 @@
+SELECT	id, name
+		FROM 	(
+					SELECT	p.*, t.teamabbrev, DENSE_RANK() OVER(PARTITION BY teamid ORDER BY createddate DESC) AS rnk
+					FROM	baseballreference.batting p
+							INNER JOIN baseballreference.team t ON t.id = p.teamid
+					WHERE	t.teamabbrev = @p1
+				) x
+		WHERE	rnk = 1
+		ORDER BY x.teamid, x.id
+-- args: [NYY]
@@ -0,0 +1,21 @@
+SELECT	*
+		FROM	(
+					SELECT	l.*, RANK() OVER(ORDER BY l.ops DESC) AS leaguerank
+					FROM	(SELECT	id, teamabbrev, rk, pos, name, age, g, pa, ab, r, h, twob, threeb, hr, rbi,
+		sb, cs, bb, so, ba, obp, slg, ops, opsplus, tb, gdp, hbp, sh, sf, ibb, createddate
+		FROM 	(
+					SELECT	p.*, t.teamabbrev, DENSE_RANK() OVER(PARTITION BY teamid ORDER BY createddate DESC) AS rnk
+					FROM	baseballreference.batting p
+							INNER JOIN baseballreference.team t ON t.id = p.teamid
+					WHERE	p.createddate >= @p1
+					AND	p.createddate <= @p2
+				) x
+		WHERE	rnk = 1
+		AND	x.pos IN (@p3, @p4)
+		) l
+					WHERE	l.ops IS NOT NULL
+					AND		COALESCE(l.pa, 0) >= @p5
+				) ranked
+		WHERE	leaguerank <= @p6
+		ORDER BY leaguerank, name
+-- args: [2020-08-08 06:00:00 +0000 UTC 2020-08-08 06:00:00 +0000 UTC 1B RF 100 10]
@@ -0,0 +1,12 @@
+SELECT	id, name, teamid
+		FROM 	(
+					SELECT	p.*, t.teamabbrev, DENSE_RANK() OVER(PARTITION BY teamid ORDER BY createddate DESC) AS rnk
+					FROM	baseballreference.batting p
+							INNER JOIN baseballreference.team t ON t.id = p.teamid
+					
+				) x
+		WHERE	rnk = 1
+		AND	(x.teamid < @p1 OR (x.teamid = @p2 AND x.id < @p3))
+		ORDER BY x.teamid DESC, x.id DESC
+		OFFSET 0 ROWS FETCH NEXT @p4 ROWS ONLY
+-- args: [1 1 2 3]
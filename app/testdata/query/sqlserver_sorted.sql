@@ -0,0 +1,13 @@
+SELECT	id, name, hr
+		FROM 	(
+					SELECT	p.*, t.teamabbrev, DENSE_RANK() OVER(PARTITION BY teamid ORDER BY createddate DESC) AS rnk
+					FROM	baseballreference.batting p
+							INNER JOIN baseballreference.team t ON t.id = p.teamid
+					WHERE	p.createddate <= @p1
+				) x
+		WHERE	rnk = 1
+		AND	x.hr >= @p2
+		AND	x.name LIKE @p3
+		ORDER BY CASE WHEN x.hr IS NULL THEN 1 ELSE 0 END, x.hr DESC, x.teamid, x.id
+		OFFSET 0 ROWS FETCH NEXT @p4 ROWS ONLY
+-- args: [2020-08-02 23:59:59 +0000 UTC 10 %judge 5]
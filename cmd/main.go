@@ -1,10 +1,18 @@
 package main
 
 import (
+	"context"
+	"fmt"
+	"io"
 	"log"
 	"os"
+	"path/filepath"
 	"sports-data-api/app"
 	"sports-data-api/db"
+	"sports-data-api/ingest"
+	"strconv"
+	"text/tabwriter"
+	"time"
 
 	"github.com/go-chi/chi"
 )
@@ -16,11 +24,67 @@ var (
 	User = os.Getenv("SDA_DB_USER")
 	Password = os.Getenv("SDA_DB_PASSWORD")
 	Database = os.Getenv("SDA_DATABASE")
+	CacheSize = os.Getenv("SDA_CACHE_SIZE")
+	CacheTTL = os.Getenv("SDA_CACHE_TTL")
+	Fixtures = os.Getenv("SDA_FIXTURES")
 )
 
-func main() {
-	r := chi.NewRouter()
-	dbc := &db.Container{
+// cacheConfig reads the response cache size (entries; 0 disables the cache) and ttl (a Go duration such as 5m),
+// falling back to the defaults when unset or invalid
+func cacheConfig() (size int, ttl time.Duration) {
+	size, ttl = app.DefaultCacheSize, app.DefaultCacheTTL
+	if CacheSize != "" {
+		n, err := strconv.Atoi(CacheSize)
+		if err != nil {
+			log.Printf("invalid SDA_CACHE_SIZE %q; using %d", CacheSize, size)
+		} else {
+			size = n
+		}
+	}
+	if CacheTTL != "" {
+		d, err := time.ParseDuration(CacheTTL)
+		if err != nil {
+			log.Printf("invalid SDA_CACHE_TTL %q; using %s", CacheTTL, ttl)
+		} else {
+			ttl = d
+		}
+	}
+	return
+}
+
+// repository serves the JSON fixtures in the SDA_FIXTURES directory when set, otherwise the configured database;
+// a SQLite database is brought up to date on start so a new file is ready to serve
+func repository() app.StatsRepository {
+	if Fixtures != "" {
+		repo, err := app.LoadMemoryRepository(Fixtures)
+		if err != nil {
+			log.Fatal(err)
+		}
+		return repo
+	}
+	dbc := container()
+	if err := dbc.Open(); err != nil {
+		log.Fatal(err)
+	}
+	if Rdbms == "sqlite" {
+		applied, err := dbc.MigrateUp()
+		for _, m := range applied {
+			log.Println("applied migration", m)
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	repo, err := app.NewSQLRepository(dbc.Db)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return repo
+}
+
+// container holds the database configuration read from the SDA_ environment variables
+func container() *db.Container {
+	return &db.Container{
 		Conf: db.Dbconfig{
 			Rdbms:    Rdbms,
 			Host:     Host,
@@ -30,13 +94,166 @@ func main() {
 			Database: Database,
 		},
 	}
-	err := dbc.Open()
+}
+
+// migrate runs `server migrate up|down|status` against the configured database: up applies every pending
+// migration, down reverts the latest applied one and status lists each migration with when it was applied
+func migrate(command string) {
+	dbc := container()
+	if err := dbc.Open(); err != nil {
+		log.Fatal(err)
+	}
+	defer dbc.Close()
+	switch command {
+	case "up":
+		applied, err := dbc.MigrateUp()
+		for _, m := range applied {
+			fmt.Println("applied", m)
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(applied) == 0 {
+			fmt.Println("no pending migrations")
+		}
+	case "down":
+		m, ok, err := dbc.MigrateDown()
+		if err != nil {
+			log.Fatal(err)
+		}
+		if !ok {
+			fmt.Println("no applied migrations")
+			return
+		}
+		fmt.Println("reverted", m)
+	case "status":
+		statuses, err := dbc.MigrationStatus()
+		if err != nil {
+			log.Fatal(err)
+		}
+		tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, "MIGRATION\tSTATUS\tAPPLIED AT")
+		for _, s := range statuses {
+			status, appliedAt := "pending", ""
+			if s.Applied {
+				status, appliedAt = "applied", s.AppliedAt.Format(time.RFC3339)
+				if s.Modified {
+					status = "modified"
+				}
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\n", s.Migration, status, appliedAt)
+		}
+		tw.Flush()
+	default:
+		log.Fatalf("unknown migrate command %q; expected up, down or status", command)
+	}
+}
+
+// ingestPage runs `server ingest <page> <team> <file>`, loading the tables of a saved Baseball-Reference team page
+// (batting, pitching, batting_splits or pitching_splits) into the configured database as a new snapshot; it only
+// adds rows, so use `server load` to replace a snapshot
+func ingestPage(name, team, file string) {
+	page, ok := ingest.Pages[name]
+	if !ok {
+		log.Fatalf("unknown page %q; expected batting, pitching, batting_splits or pitching_splits", name)
+	}
+	f, err := os.Open(file)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+	dbc := container()
+	if err := dbc.Open(); err != nil {
+		log.Fatal(err)
+	}
+	defer dbc.Close()
+	ctx := context.Background()
+	in := ingest.NewIngester(dbc.Db)
+	teamid, err := in.TeamID(ctx, team)
 	if err != nil {
-		log.Println(err.Error())
+		log.Fatal(err)
+	}
+	if err := in.IngestPage(ctx, page, teamid, f, time.Now().UTC().Truncate(time.Second)); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// loadSnapshot runs `server load <team> <dir> [run]`, loading the saved pages <dir>/<page>.html of a team as one
+// snapshot in a single transaction on postgres or sqlite; passing the id of an earlier run retries it, replacing the
+// rows it wrote
+func loadSnapshot(team, dir, id string) {
+	pages := map[string]io.Reader{}
+	for name := range ingest.Pages {
+		f, err := os.Open(filepath.Join(dir, name+".html"))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		pages[name] = f
+	}
+	if len(pages) == 0 {
+		log.Fatalf("no saved pages in %s", dir)
+	}
+	dbc := container()
+	if err := dbc.Open(); err != nil {
+		log.Fatal(err)
+	}
+	defer dbc.Close()
+	ctx := context.Background()
+	in := ingest.NewIngester(dbc.Db)
+	teamid, err := in.TeamID(ctx, team)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var run ingest.Run
+	if id == "" {
+		run, err = ingest.NewRun(teamid)
+	} else if run, err = in.Run(ctx, id); err == nil && run.Teamid != teamid {
+		err = fmt.Errorf("run %s is not a load of %s", id, team)
 	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := in.LoadSnapshot(ctx, run, pages); err != nil {
+		log.Fatalf("run %s: %v", run.ID, err)
+	}
+	fmt.Println(run.ID)
+}
+
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if len(os.Args) != 3 {
+			log.Fatal("usage: server migrate up|down|status")
+		}
+		migrate(os.Args[2])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "ingest" {
+		if len(os.Args) != 5 {
+			log.Fatal("usage: server ingest batting|pitching|batting_splits|pitching_splits <team> <file>")
+		}
+		ingestPage(os.Args[2], os.Args[3], os.Args[4])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "load" {
+		if len(os.Args) != 4 && len(os.Args) != 5 {
+			log.Fatal("usage: server load <team> <dir> [run]")
+		}
+		var id string
+		if len(os.Args) == 5 {
+			id = os.Args[4]
+		}
+		loadSnapshot(os.Args[2], os.Args[3], id)
+		return
+	}
+	r := chi.NewRouter()
 	server := &app.Server{
-		Dbc:         dbc,
+		Repo:        repository(),
 		Router:      r,
+		Cache:       app.NewCache(cacheConfig()),
 	}
-	server.Start()
+	log.Fatal(server.Start())
 }
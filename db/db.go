@@ -32,6 +32,16 @@ var (
 	errDbConf = errors.New("all database configuration fields must be set")
 )
 
+// Schema returns the qualifier prefixed to the baseballreference table names on an Rdbms; mysql has no schemas
+// within a database and SQLite none at all, so there the tables live in the connected database
+func Schema(rdbms string) string {
+	switch rdbms {
+	case "mysql", sqliteDriver:
+		return ""
+	}
+	return "baseballreference."
+}
+
 // Close performs the release of any resources that `sql/database` DB pool created.
 func (dbc *Container) Close() (err error) {
 	if dbc.Db == nil {
@@ -48,8 +58,7 @@ func (dbc *Container) Close() (err error) {
 
 // Open creates a database connection and pings the database to verify connection is alive
 func (dbc *Container) Open() (err error) {
-	// Check to see if any config string fields were left empty
-	if dbc.Conf.Rdbms == "" || dbc.Conf.Host == "" || dbc.Conf.Port == "" || dbc.Conf.User == "" || dbc.Conf.Password == "" || dbc.Conf.Database == "" {
+	if !dbc.Conf.complete() {
 		err = errDbConf
 		return
 	}
@@ -67,17 +76,28 @@ func (dbc *Container) Open() (err error) {
 	return
 }
 
+// complete checks that no config string field was left empty; SQLite only needs the database file path
+func (conf *Dbconfig) complete() bool {
+	if conf.Rdbms == sqliteDriver {
+		return conf.Database != ""
+	}
+	return conf.Rdbms != "" && conf.Host != "" && conf.Port != "" && conf.User != "" && conf.Password != "" && conf.Database != ""
+}
+
 func (conf *Dbconfig) createConnString() (connString string) {
 	switch conf.Rdbms {
 	case "postgres":
 		connString = fmt.Sprintf("host=%s port=%s dbname=%s user=%s password=%s sslmode=disable", conf.Host, conf.Port, conf.Database, conf.User, conf.Password)
 	case "mysql":
-		connString = fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8", conf.User, conf.Password, conf.Host, conf.Port, conf.Database)
+		connString = fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8&parseTime=true&multiStatements=true", conf.User, conf.Password, conf.Host, conf.Port, conf.Database)
 	case "sqlserver":
 		if conf.WinAuth {
 			connString = fmt.Sprintf("server=%s; port=%s; database=%s; trusted_connection=yes;", conf.Host, conf.Port, conf.Database)
+		} else {
+			connString = fmt.Sprintf("server=%s; port=%s; database=%s; user id=%s; password=%s", conf.Host, conf.Port, conf.Database, conf.User, conf.Password)
 		}
-		connString = fmt.Sprintf("server=%s; port=%s; database=%s; user id=%s; password=%s", conf.Host, conf.Port, conf.Database, conf.User, conf.Password)
+	case sqliteDriver:
+		connString = fmt.Sprintf("file:%s?_foreign_keys=on&_busy_timeout=5000", conf.Database)
 	}
 	return
 }
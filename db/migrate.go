@@ -0,0 +1,247 @@
+package db
+
+import (
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/denisenkom/go-mssqldb/batch"
+	"github.com/pkg/errors"
+)
+
+// migrationFiles holds the versioned schema of each supported Rdbms as migrations/<rdbms>/NNNN_name.up.sql and
+// NNNN_name.down.sql pairs
+//
+//go:embed migrations
+var migrationFiles embed.FS
+
+var migrationFile = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// createMigrationsTable records the applied migrations; the DDL is common to every supported Rdbms but SQL Server,
+// which has no CREATE TABLE IF NOT EXISTS and whose TIMESTAMP is a row version
+const createMigrationsTable = `CREATE TABLE IF NOT EXISTS schema_migrations (
+	version INTEGER PRIMARY KEY,
+	name TEXT NOT NULL,
+	checksum TEXT NOT NULL,
+	appliedat TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+)`
+
+const createSQLServerMigrationsTable = `IF OBJECT_ID('schema_migrations') IS NULL CREATE TABLE schema_migrations (
+	version INT PRIMARY KEY,
+	name NVARCHAR(255) NOT NULL,
+	checksum NVARCHAR(64) NOT NULL,
+	appliedat DATETIME2 NOT NULL DEFAULT SYSUTCDATETIME()
+)`
+
+// Migration is a single versioned schema change
+type Migration struct {
+	Version  int
+	Name     string
+	Up       string
+	Down     string
+	Checksum string // sha256 of Up, recorded in schema_migrations when applied
+}
+
+func (m Migration) String() string {
+	return fmt.Sprintf("%04d_%s", m.Version, m.Name)
+}
+
+// MigrationStatus reports whether a migration has been applied and whether its up script still matches
+type MigrationStatus struct {
+	Migration
+	Applied   bool
+	AppliedAt time.Time
+	Modified  bool // the up script differs from the one applied
+}
+
+// appliedMigration is a row of schema_migrations
+type appliedMigration struct {
+	Version   int
+	Name      string
+	Checksum  string
+	Appliedat time.Time
+}
+
+// migrations reads the embedded migrations of an Rdbms ordered by version
+func migrations(rdbms string) ([]Migration, error) {
+	dir := path.Join("migrations", rdbms)
+	entries, err := migrationFiles.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Errorf("no migrations for rdbms %q", rdbms)
+	}
+	byVersion := map[int]*Migration{}
+	for _, entry := range entries {
+		match := migrationFile.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, errors.Errorf("unexpected migration file %s", entry.Name())
+		}
+		version, _ := strconv.Atoi(match[1])
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		} else if m.Name != match[2] {
+			return nil, errors.Errorf("migration version %d is used by %s and %s", version, m.Name, match[2])
+		}
+		script, err := migrationFiles.ReadFile(path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reading migration %s", entry.Name())
+		}
+		if match[3] == "up" {
+			m.Up = string(script)
+			sum := sha256.Sum256(script)
+			m.Checksum = hex.EncodeToString(sum[:])
+		} else {
+			m.Down = string(script)
+		}
+	}
+	list := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, errors.Errorf("migration %s needs both an up and a down script", m)
+		}
+		list = append(list, *m)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Version < list[j].Version })
+	return list, nil
+}
+
+// applied reads schema_migrations, creating it on first use
+func (dbc *Container) applied() (map[int]appliedMigration, error) {
+	ddl := createMigrationsTable
+	if dbc.Conf.Rdbms == "sqlserver" {
+		ddl = createSQLServerMigrationsTable
+	}
+	if _, err := dbc.Db.Exec(ddl); err != nil {
+		return nil, errors.Wrap(err, "error creating schema_migrations")
+	}
+	var rows []appliedMigration
+	if err := dbc.Db.Select(&rows, "SELECT version, name, checksum, appliedat FROM schema_migrations"); err != nil {
+		return nil, errors.Wrap(err, "error reading schema_migrations")
+	}
+	applied := make(map[int]appliedMigration, len(rows))
+	for _, row := range rows {
+		applied[row.Version] = row
+	}
+	return applied, nil
+}
+
+// MigrationStatus lists the embedded migrations of the configured Rdbms with their applied state
+func (dbc *Container) MigrationStatus() ([]MigrationStatus, error) {
+	list, err := migrations(dbc.Conf.Rdbms)
+	if err != nil {
+		return nil, err
+	}
+	applied, err := dbc.applied()
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]MigrationStatus, len(list))
+	for i, m := range list {
+		statuses[i] = MigrationStatus{Migration: m}
+		if row, ok := applied[m.Version]; ok {
+			statuses[i].Applied = true
+			statuses[i].AppliedAt = row.Appliedat
+			statuses[i].Modified = row.Checksum != m.Checksum
+		}
+	}
+	return statuses, nil
+}
+
+// verified returns the migrations and their applied state once every applied migration is known and unchanged;
+// migrating a database whose history differs from the embedded scripts would leave its schema unaccounted for
+func (dbc *Container) verified() ([]Migration, map[int]appliedMigration, error) {
+	list, err := migrations(dbc.Conf.Rdbms)
+	if err != nil {
+		return nil, nil, err
+	}
+	applied, err := dbc.applied()
+	if err != nil {
+		return nil, nil, err
+	}
+	known := make(map[int]bool, len(list))
+	for _, m := range list {
+		known[m.Version] = true
+		if row, ok := applied[m.Version]; ok && row.Checksum != m.Checksum {
+			return nil, nil, errors.Errorf("migration %s has changed since it was applied", m)
+		}
+	}
+	for version, row := range applied {
+		if !known[version] {
+			return nil, nil, errors.Errorf("applied migration %04d_%s is not in this build", version, row.Name)
+		}
+	}
+	return list, applied, nil
+}
+
+// MigrateUp applies every pending migration in version order, each in its own transaction with its
+// schema_migrations row, and returns the migrations applied
+func (dbc *Container) MigrateUp() ([]Migration, error) {
+	list, applied, err := dbc.verified()
+	if err != nil {
+		return nil, err
+	}
+	var done []Migration
+	for _, m := range list {
+		if _, ok := applied[m.Version]; ok {
+			continue
+		}
+		err := dbc.migrate(m, m.Up, "INSERT INTO schema_migrations (version, name, checksum) VALUES (?, ?, ?)", m.Version, m.Name, m.Checksum)
+		if err != nil {
+			return done, err
+		}
+		done = append(done, m)
+	}
+	return done, nil
+}
+
+// MigrateDown reverts the most recently applied migration; ok is false when none has been applied
+func (dbc *Container) MigrateDown() (m Migration, ok bool, err error) {
+	list, applied, err := dbc.verified()
+	if err != nil {
+		return
+	}
+	for i := len(list) - 1; i >= 0; i-- {
+		if _, ok = applied[list[i].Version]; ok {
+			m = list[i]
+			err = dbc.migrate(m, m.Down, "DELETE FROM schema_migrations WHERE version = ?", m.Version)
+			return
+		}
+	}
+	return
+}
+
+// batches splits a migration script into the batches sent to the database one at a time. SQL Server scripts are
+// split on GO lines, as CREATE SCHEMA must start a batch of its own; every other Rdbms takes the whole script, mysql
+// through the multiStatements connection option
+func (dbc *Container) batches(script string) []string {
+	if dbc.Conf.Rdbms == "sqlserver" {
+		return batch.Split(script, "GO")
+	}
+	return []string{script}
+}
+
+// migrate runs a migration script and the schema_migrations statement recording it in one transaction; mysql
+// commits each DDL statement implicitly, so there a failed migration can leave its earlier statements applied
+func (dbc *Container) migrate(m Migration, script, record string, args ...interface{}) error {
+	tx, err := dbc.Db.Beginx()
+	if err != nil {
+		return errors.Wrap(err, "error starting migration")
+	}
+	defer tx.Rollback()
+	for _, b := range dbc.batches(script) {
+		if _, err = tx.Exec(b); err != nil {
+			return errors.Wrapf(err, "error running migration %s", m)
+		}
+	}
+	if _, err = tx.Exec(tx.Rebind(record), args...); err != nil {
+		return errors.Wrapf(err, "error recording migration %s", m)
+	}
+	return errors.Wrapf(tx.Commit(), "error committing migration %s", m)
+}
@@ -0,0 +1,198 @@
+package db
+
+import (
+	"strings"
+	"testing"
+)
+
+// memoryContainer opens an in-memory SQLite database; the pool is held to one connection, as each connection to
+// :memory: would otherwise open a database of its own
+func memoryContainer(t *testing.T) *Container {
+	t.Helper()
+	dbc := &Container{Conf: Dbconfig{Rdbms: sqliteDriver, Database: ":memory:"}}
+	if err := dbc.Open(); err != nil {
+		t.Fatal(err)
+	}
+	dbc.Db.SetMaxOpenConns(1)
+	t.Cleanup(func() { dbc.Close() })
+	return dbc
+}
+
+func migrateUp(t *testing.T, dbc *Container) []Migration {
+	t.Helper()
+	applied, err := dbc.MigrateUp()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return applied
+}
+
+func count(t *testing.T, dbc *Container, query string) int {
+	t.Helper()
+	var n int
+	if err := dbc.Db.Get(&n, query); err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+func TestMigrations(t *testing.T) {
+	for _, rdbms := range []string{"mysql", "postgres", "sqlite", "sqlserver"} {
+		list, err := migrations(rdbms)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, m := range list {
+			if m.Version != i+1 || m.Up == "" || m.Down == "" || len(m.Checksum) != 64 {
+				t.Errorf("%s: migration %d is %s with checksum %q, want version %d with both scripts", rdbms, i, m, m.Checksum, i+1)
+			}
+		}
+	}
+	if _, err := migrations("oracle"); err == nil {
+		t.Error("migrations(\"oracle\"): want an error for an rdbms without migrations")
+	}
+}
+
+func TestMigrateUp(t *testing.T) {
+	dbc := memoryContainer(t)
+	applied := migrateUp(t, dbc)
+	var names []string
+	for _, m := range applied {
+		names = append(names, m.String())
+	}
+	if got, want := strings.Join(names, " "), "0001_baseballreference 0002_users 0003_teams 0004_load_runs"; got != want {
+		t.Errorf("applied %s, want %s", got, want)
+	}
+	if n := count(t, dbc, "SELECT COUNT(*) FROM team"); n != 30 {
+		t.Errorf("%d teams, want 30", n)
+	}
+	if again := migrateUp(t, dbc); len(again) != 0 {
+		t.Errorf("second MigrateUp applied %v, want none", again)
+	}
+	statuses, err := dbc.MigrationStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range statuses {
+		if !s.Applied || s.AppliedAt.IsZero() || s.Modified {
+			t.Errorf("%s: applied %v at %v modified %v, want applied and unmodified", s, s.Applied, s.AppliedAt, s.Modified)
+		}
+	}
+}
+
+func TestMigrateDown(t *testing.T) {
+	dbc := memoryContainer(t)
+	list := migrateUp(t, dbc)
+	for i := len(list) - 1; i >= 0; i-- {
+		m, ok, err := dbc.MigrateDown()
+		if err != nil || !ok || m.Version != list[i].Version {
+			t.Fatalf("MigrateDown: %s %v %v, want %s", m, ok, err, list[i])
+		}
+	}
+	if _, ok, err := dbc.MigrateDown(); ok || err != nil {
+		t.Errorf("MigrateDown with nothing applied: %v %v, want false and no error", ok, err)
+	}
+	if n := count(t, dbc, "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name NOT IN ('schema_migrations', 'sqlite_sequence')"); n != 0 {
+		t.Errorf("%d tables left, want 0", n)
+	}
+	statuses, err := dbc.MigrationStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range statuses {
+		if s.Applied {
+			t.Errorf("%s still applied", s)
+		}
+	}
+}
+
+func TestTeamSeedKeepsExistingTeams(t *testing.T) {
+	dbc := memoryContainer(t)
+	migrateUp(t, dbc)
+	for i := 0; i < 2; i++ { // 0004_load_runs, then 0003_teams
+		if _, _, err := dbc.MigrateDown(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := dbc.Db.Exec("INSERT INTO team (id, teamname, teamabbrev) VALUES (100, 'New York Yankees', 'NYY')"); err != nil {
+		t.Fatal(err)
+	}
+	migrateUp(t, dbc)
+	if n := count(t, dbc, "SELECT COUNT(*) FROM team"); n != 30 {
+		t.Errorf("%d teams, want 30", n)
+	}
+	if id := count(t, dbc, "SELECT id FROM team WHERE teamabbrev = 'NYY'"); id != 100 {
+		t.Errorf("NYY id %d, want 100", id)
+	}
+}
+
+func TestMigrationChecksumMismatch(t *testing.T) {
+	dbc := memoryContainer(t)
+	migrateUp(t, dbc)
+	if _, err := dbc.Db.Exec("UPDATE schema_migrations SET checksum = 'edited' WHERE version = 1"); err != nil {
+		t.Fatal(err)
+	}
+	statuses, err := dbc.MigrationStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range statuses {
+		if s.Modified != (s.Version == 1) {
+			t.Errorf("%s: modified %v", s, s.Modified)
+		}
+	}
+	if _, err := dbc.MigrateUp(); err == nil || !strings.Contains(err.Error(), "0001_baseballreference has changed") {
+		t.Errorf("MigrateUp: %v, want the changed migration reported", err)
+	}
+	if _, _, err := dbc.MigrateDown(); err == nil {
+		t.Error("MigrateDown: want an error while a migration has changed")
+	}
+}
+
+func TestUnknownAppliedMigration(t *testing.T) {
+	dbc := memoryContainer(t)
+	migrateUp(t, dbc)
+	if _, err := dbc.Db.Exec("INSERT INTO schema_migrations (version, name, checksum) VALUES (99, 'future', 'x')"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dbc.MigrateUp(); err == nil || !strings.Contains(err.Error(), "0099_future is not in this build") {
+		t.Errorf("MigrateUp: %v, want the unknown migration reported", err)
+	}
+}
+
+func TestLoadRunsDedup(t *testing.T) {
+	dbc := memoryContainer(t)
+	migrateUp(t, dbc)
+	if _, _, err := dbc.MigrateDown(); err != nil { // 0004_load_runs
+		t.Fatal(err)
+	}
+	for _, name := range []string{"Aaron Judge", "Aaron Judge", "Gary Sanchez", "", ""} {
+		var v interface{} = name
+		if name == "" {
+			v = nil
+		}
+		if _, err := dbc.Db.Exec("INSERT INTO batting (teamid, name, hr, createddate) VALUES (1, ?, (SELECT COUNT(*) FROM batting), '2020-08-01 06:00:00')", v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	migrateUp(t, dbc)
+	if n := count(t, dbc, "SELECT COUNT(*) FROM batting"); n != 4 {
+		t.Errorf("%d batting rows, want 4 with the duplicate Judge removed", n)
+	}
+	if hr := count(t, dbc, "SELECT hr FROM batting WHERE name = 'Aaron Judge'"); hr != 1 {
+		t.Errorf("Judge kept the row with hr %d, want the row written last", hr)
+	}
+	if n := count(t, dbc, "SELECT COUNT(*) FROM batting WHERE name IS NULL"); n != 2 {
+		t.Errorf("%d rows without a name, want both kept", n)
+	}
+}
+
+func TestBatches(t *testing.T) {
+	script := "CREATE SCHEMA s;\nGO\n\nCREATE TABLE s.t (id INT);\n"
+	if got := (&Container{Conf: Dbconfig{Rdbms: "sqlserver"}}).batches(script); len(got) != 2 || strings.TrimSpace(got[1]) != "CREATE TABLE s.t (id INT);" {
+		t.Errorf("sqlserver batches %q, want the script split on GO", got)
+	}
+	if got := (&Container{Conf: Dbconfig{Rdbms: "mysql"}}).batches(script); len(got) != 1 || got[0] != script {
+		t.Errorf("mysql batches %q, want the whole script", got)
+	}
+}
@@ -0,0 +1,10 @@
+DROP TABLE audit;
+DROP TABLE pitching_home_away;
+DROP TABLE batting_home_away;
+DROP TABLE batting_pitching;
+DROP TABLE baserunning;
+DROP TABLE pitching_splits;
+DROP TABLE batting_splits;
+DROP TABLE pitching;
+DROP TABLE batting;
+DROP TABLE team;
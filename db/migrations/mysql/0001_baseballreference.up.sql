@@ -0,0 +1,125 @@
+-- the team, stat and audit tables the scraper writes and the API reads; mysql has no schemas within a database, so
+-- the tables live in the configured one. Tables are created only when missing so a database the scraper already
+-- populated is adopted as the baseline
+CREATE TABLE IF NOT EXISTS team (
+	id INTEGER AUTO_INCREMENT PRIMARY KEY,
+	teamname VARCHAR(255) NOT NULL,
+	teamabbrev VARCHAR(255) NOT NULL UNIQUE
+);
+
+CREATE TABLE IF NOT EXISTS batting (
+	id INTEGER AUTO_INCREMENT PRIMARY KEY,
+	teamid INTEGER NOT NULL,
+	rk INTEGER, pos VARCHAR(255), name VARCHAR(255), age INTEGER, g INTEGER, pa INTEGER, ab INTEGER, r INTEGER,
+	h INTEGER, twob INTEGER, threeb INTEGER, hr INTEGER, rbi INTEGER, sb INTEGER, cs INTEGER,
+	bb INTEGER, so INTEGER, ba DOUBLE PRECISION, obp DOUBLE PRECISION, slg DOUBLE PRECISION,
+	ops DOUBLE PRECISION, opsplus INTEGER, tb INTEGER, gdp INTEGER, hbp INTEGER, sh INTEGER, sf INTEGER,
+	ibb INTEGER,
+	createddate DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	INDEX batting_teamid_createddate (teamid, createddate),
+	FOREIGN KEY (teamid) REFERENCES team (id)
+);
+
+CREATE TABLE IF NOT EXISTS pitching (
+	id INTEGER AUTO_INCREMENT PRIMARY KEY,
+	teamid INTEGER NOT NULL,
+	rk INTEGER, pos VARCHAR(255), name VARCHAR(255), age INTEGER, w INTEGER, l INTEGER, wl DOUBLE PRECISION,
+	era DOUBLE PRECISION, g INTEGER, gs INTEGER, gf INTEGER, cg INTEGER, sho INTEGER, sv INTEGER,
+	ip DOUBLE PRECISION, h INTEGER, r INTEGER, er INTEGER, hr INTEGER, bb INTEGER, ibb INTEGER,
+	so INTEGER, hbp INTEGER, bk INTEGER, wp INTEGER, bf INTEGER, eraplus INTEGER, fip DOUBLE PRECISION,
+	whip DOUBLE PRECISION, h9 DOUBLE PRECISION, hr9 DOUBLE PRECISION, bb9 DOUBLE PRECISION,
+	so9 DOUBLE PRECISION, sow DOUBLE PRECISION,
+	createddate DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	INDEX pitching_teamid_createddate (teamid, createddate),
+	FOREIGN KEY (teamid) REFERENCES team (id)
+);
+
+CREATE TABLE IF NOT EXISTS batting_splits (
+	id INTEGER AUTO_INCREMENT PRIMARY KEY,
+	teamid INTEGER NOT NULL,
+	split VARCHAR(255), g INTEGER, gs INTEGER, pa INTEGER, ab INTEGER, r INTEGER, h INTEGER, twob INTEGER,
+	threeb INTEGER, hr INTEGER, rbi INTEGER, sb INTEGER, cs INTEGER, bb INTEGER, so INTEGER,
+	ba DOUBLE PRECISION, obp DOUBLE PRECISION, slg DOUBLE PRECISION, ops DOUBLE PRECISION, tb INTEGER,
+	gdp INTEGER, hbp INTEGER, sh INTEGER, sf INTEGER, ibb INTEGER, roe INTEGER, babip DOUBLE PRECISION,
+	topsplus INTEGER, sopsplus INTEGER,
+	createddate DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	INDEX batting_splits_teamid_createddate (teamid, createddate),
+	FOREIGN KEY (teamid) REFERENCES team (id)
+);
+
+CREATE TABLE IF NOT EXISTS pitching_splits (
+	id INTEGER AUTO_INCREMENT PRIMARY KEY,
+	teamid INTEGER NOT NULL,
+	split VARCHAR(255), g INTEGER, pa INTEGER, ab INTEGER, r INTEGER, h INTEGER, twob INTEGER, threeb INTEGER,
+	hr INTEGER, sb INTEGER, cs INTEGER, bb INTEGER, so INTEGER, sow DOUBLE PRECISION,
+	ba DOUBLE PRECISION, obp DOUBLE PRECISION, slg DOUBLE PRECISION, ops DOUBLE PRECISION, tb INTEGER,
+	gdp INTEGER, hbp INTEGER, sh INTEGER, sf INTEGER, ibb INTEGER, roe INTEGER, babip DOUBLE PRECISION,
+	topsplus INTEGER, sopsplus INTEGER,
+	createddate DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	INDEX pitching_splits_teamid_createddate (teamid, createddate),
+	FOREIGN KEY (teamid) REFERENCES team (id)
+);
+
+CREATE TABLE IF NOT EXISTS baserunning (
+	id INTEGER AUTO_INCREMENT PRIMARY KEY,
+	teamid INTEGER NOT NULL,
+	name VARCHAR(255), age INTEGER, pa INTEGER, roe INTEGER, xi INTEGER, rspct VARCHAR(255), sbo INTEGER, sb INTEGER,
+	cs INTEGER, sbpct VARCHAR(255), sb2 INTEGER, cs2 INTEGER, sb3 INTEGER, cs3 INTEGER, sbh INTEGER,
+	csh INTEGER, po INTEGER, pcs INTEGER, oob INTEGER, oob1 INTEGER, oob2 INTEGER, oob3 INTEGER,
+	oobhm INTEGER, bt INTEGER, xbtpct VARCHAR(255), firsts INTEGER, firsts2 INTEGER, firsts3 INTEGER,
+	firstd INTEGER, firstd3 INTEGER, firstdh INTEGER, seconds INTEGER, seconds3 INTEGER,
+	secondsh INTEGER,
+	createddate DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	INDEX baserunning_teamid_createddate (teamid, createddate),
+	FOREIGN KEY (teamid) REFERENCES team (id)
+);
+
+CREATE TABLE IF NOT EXISTS batting_pitching (
+	id INTEGER AUTO_INCREMENT PRIMARY KEY,
+	teamid INTEGER NOT NULL,
+	rk INTEGER, name VARCHAR(255), age INTEGER, ip DOUBLE PRECISION, g INTEGER, pa INTEGER, ab INTEGER,
+	r INTEGER, h INTEGER, twob INTEGER, threeb INTEGER, hr INTEGER, sb INTEGER, cs INTEGER, bb INTEGER,
+	so INTEGER, ba DOUBLE PRECISION, obp DOUBLE PRECISION, slg DOUBLE PRECISION, ops DOUBLE PRECISION,
+	tb INTEGER, gdp INTEGER, hbp INTEGER, sh INTEGER, sf INTEGER, ibb INTEGER, roe INTEGER,
+	babip DOUBLE PRECISION,
+	createddate DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	INDEX batting_pitching_teamid_createddate (teamid, createddate),
+	FOREIGN KEY (teamid) REFERENCES team (id)
+);
+
+CREATE TABLE IF NOT EXISTS batting_home_away (
+	id INTEGER AUTO_INCREMENT PRIMARY KEY,
+	teamid INTEGER NOT NULL,
+	split VARCHAR(255), g INTEGER, gs INTEGER, pa INTEGER, ab INTEGER, r INTEGER, h INTEGER, twob INTEGER,
+	threeb INTEGER, hr INTEGER, rbi INTEGER, sb INTEGER, cs INTEGER, bb INTEGER, so INTEGER,
+	ba DOUBLE PRECISION, obp DOUBLE PRECISION, slg DOUBLE PRECISION, ops DOUBLE PRECISION, tb INTEGER,
+	gdp INTEGER, hbp INTEGER, sh INTEGER, sf INTEGER, ibb INTEGER, roe INTEGER, babip DOUBLE PRECISION,
+	topsplus INTEGER, sopsplus INTEGER,
+	createddate DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	INDEX batting_home_away_teamid_createddate (teamid, createddate),
+	FOREIGN KEY (teamid) REFERENCES team (id)
+);
+
+CREATE TABLE IF NOT EXISTS pitching_home_away (
+	id INTEGER AUTO_INCREMENT PRIMARY KEY,
+	teamid INTEGER NOT NULL,
+	split VARCHAR(255), g INTEGER, pa INTEGER, ab INTEGER, r INTEGER, h INTEGER, twob INTEGER, threeb INTEGER,
+	hr INTEGER, sb INTEGER, cs INTEGER, bb INTEGER, so INTEGER, sow DOUBLE PRECISION,
+	ba DOUBLE PRECISION, obp DOUBLE PRECISION, slg DOUBLE PRECISION, ops DOUBLE PRECISION, tb INTEGER,
+	gdp INTEGER, hbp INTEGER, sh INTEGER, sf INTEGER, ibb INTEGER, roe INTEGER, babip DOUBLE PRECISION,
+	topsplus INTEGER, sopsplus INTEGER,
+	createddate DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	INDEX pitching_home_away_teamid_createddate (teamid, createddate),
+	FOREIGN KEY (teamid) REFERENCES team (id)
+);
+
+-- statusid: 0 success, 1 insert_error, 2 request_timeout, 3 table_timeout, 4 parse_error
+CREATE TABLE IF NOT EXISTS audit (
+	id INTEGER AUTO_INCREMENT PRIMARY KEY,
+	statusid INTEGER NOT NULL,
+	teamid INTEGER,
+	tablename VARCHAR(255),
+	error TEXT,
+	createddate DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	FOREIGN KEY (teamid) REFERENCES team (id)
+);
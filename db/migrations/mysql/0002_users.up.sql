@@ -0,0 +1,9 @@
+-- API users; pass holds bcrypt hashes as basic_auth.users does in Postgres. mysql has no bcrypt, so the API reads
+-- the hash of a user and checks the password itself
+CREATE TABLE IF NOT EXISTS users (
+	id INTEGER AUTO_INCREMENT PRIMARY KEY,
+	email VARCHAR(255) NOT NULL UNIQUE,
+	pass VARCHAR(511) NOT NULL,
+	role VARCHAR(511) NOT NULL,
+	verified BOOLEAN NOT NULL DEFAULT false
+);
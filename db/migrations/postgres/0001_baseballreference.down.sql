@@ -0,0 +1 @@
+DROP SCHEMA baseballreference CASCADE;
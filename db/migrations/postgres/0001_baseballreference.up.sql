@@ -0,0 +1,117 @@
+-- the team, stat and audit tables the scraper writes and the API reads; every object is created only when missing
+-- so a database the scraper already populated is adopted as the baseline
+CREATE SCHEMA IF NOT EXISTS baseballreference;
+
+CREATE TABLE IF NOT EXISTS baseballreference.team (
+	id SERIAL PRIMARY KEY,
+	teamname TEXT NOT NULL,
+	teamabbrev TEXT NOT NULL UNIQUE
+);
+
+CREATE TABLE IF NOT EXISTS baseballreference.batting (
+	id SERIAL PRIMARY KEY,
+	teamid INTEGER NOT NULL REFERENCES baseballreference.team (id),
+	rk INTEGER, pos TEXT, name TEXT, age INTEGER, g INTEGER, pa INTEGER, ab INTEGER, r INTEGER,
+	h INTEGER, twob INTEGER, threeb INTEGER, hr INTEGER, rbi INTEGER, sb INTEGER, cs INTEGER,
+	bb INTEGER, so INTEGER, ba DOUBLE PRECISION, obp DOUBLE PRECISION, slg DOUBLE PRECISION,
+	ops DOUBLE PRECISION, opsplus INTEGER, tb INTEGER, gdp INTEGER, hbp INTEGER, sh INTEGER, sf INTEGER,
+	ibb INTEGER,
+	createddate TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS batting_teamid_createddate ON baseballreference.batting (teamid, createddate);
+
+CREATE TABLE IF NOT EXISTS baseballreference.pitching (
+	id SERIAL PRIMARY KEY,
+	teamid INTEGER NOT NULL REFERENCES baseballreference.team (id),
+	rk INTEGER, pos TEXT, name TEXT, age INTEGER, w INTEGER, l INTEGER, wl DOUBLE PRECISION,
+	era DOUBLE PRECISION, g INTEGER, gs INTEGER, gf INTEGER, cg INTEGER, sho INTEGER, sv INTEGER,
+	ip DOUBLE PRECISION, h INTEGER, r INTEGER, er INTEGER, hr INTEGER, bb INTEGER, ibb INTEGER,
+	so INTEGER, hbp INTEGER, bk INTEGER, wp INTEGER, bf INTEGER, eraplus INTEGER, fip DOUBLE PRECISION,
+	whip DOUBLE PRECISION, h9 DOUBLE PRECISION, hr9 DOUBLE PRECISION, bb9 DOUBLE PRECISION,
+	so9 DOUBLE PRECISION, sow DOUBLE PRECISION,
+	createddate TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS pitching_teamid_createddate ON baseballreference.pitching (teamid, createddate);
+
+CREATE TABLE IF NOT EXISTS baseballreference.batting_splits (
+	id SERIAL PRIMARY KEY,
+	teamid INTEGER NOT NULL REFERENCES baseballreference.team (id),
+	split TEXT, g INTEGER, gs INTEGER, pa INTEGER, ab INTEGER, r INTEGER, h INTEGER, twob INTEGER,
+	threeb INTEGER, hr INTEGER, rbi INTEGER, sb INTEGER, cs INTEGER, bb INTEGER, so INTEGER,
+	ba DOUBLE PRECISION, obp DOUBLE PRECISION, slg DOUBLE PRECISION, ops DOUBLE PRECISION, tb INTEGER,
+	gdp INTEGER, hbp INTEGER, sh INTEGER, sf INTEGER, ibb INTEGER, roe INTEGER, babip DOUBLE PRECISION,
+	topsplus INTEGER, sopsplus INTEGER,
+	createddate TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS batting_splits_teamid_createddate ON baseballreference.batting_splits (teamid, createddate);
+
+CREATE TABLE IF NOT EXISTS baseballreference.pitching_splits (
+	id SERIAL PRIMARY KEY,
+	teamid INTEGER NOT NULL REFERENCES baseballreference.team (id),
+	split TEXT, g INTEGER, pa INTEGER, ab INTEGER, r INTEGER, h INTEGER, twob INTEGER, threeb INTEGER,
+	hr INTEGER, sb INTEGER, cs INTEGER, bb INTEGER, so INTEGER, sow DOUBLE PRECISION,
+	ba DOUBLE PRECISION, obp DOUBLE PRECISION, slg DOUBLE PRECISION, ops DOUBLE PRECISION, tb INTEGER,
+	gdp INTEGER, hbp INTEGER, sh INTEGER, sf INTEGER, ibb INTEGER, roe INTEGER, babip DOUBLE PRECISION,
+	topsplus INTEGER, sopsplus INTEGER,
+	createddate TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS pitching_splits_teamid_createddate ON baseballreference.pitching_splits (teamid, createddate);
+
+CREATE TABLE IF NOT EXISTS baseballreference.baserunning (
+	id SERIAL PRIMARY KEY,
+	teamid INTEGER NOT NULL REFERENCES baseballreference.team (id),
+	name TEXT, age INTEGER, pa INTEGER, roe INTEGER, xi INTEGER, rspct TEXT, sbo INTEGER, sb INTEGER,
+	cs INTEGER, sbpct TEXT, sb2 INTEGER, cs2 INTEGER, sb3 INTEGER, cs3 INTEGER, sbh INTEGER,
+	csh INTEGER, po INTEGER, pcs INTEGER, oob INTEGER, oob1 INTEGER, oob2 INTEGER, oob3 INTEGER,
+	oobhm INTEGER, bt INTEGER, xbtpct TEXT, firsts INTEGER, firsts2 INTEGER, firsts3 INTEGER,
+	firstd INTEGER, firstd3 INTEGER, firstdh INTEGER, seconds INTEGER, seconds3 INTEGER,
+	secondsh INTEGER,
+	createddate TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS baserunning_teamid_createddate ON baseballreference.baserunning (teamid, createddate);
+
+CREATE TABLE IF NOT EXISTS baseballreference.batting_pitching (
+	id SERIAL PRIMARY KEY,
+	teamid INTEGER NOT NULL REFERENCES baseballreference.team (id),
+	rk INTEGER, name TEXT, age INTEGER, ip DOUBLE PRECISION, g INTEGER, pa INTEGER, ab INTEGER,
+	r INTEGER, h INTEGER, twob INTEGER, threeb INTEGER, hr INTEGER, sb INTEGER, cs INTEGER, bb INTEGER,
+	so INTEGER, ba DOUBLE PRECISION, obp DOUBLE PRECISION, slg DOUBLE PRECISION, ops DOUBLE PRECISION,
+	tb INTEGER, gdp INTEGER, hbp INTEGER, sh INTEGER, sf INTEGER, ibb INTEGER, roe INTEGER,
+	babip DOUBLE PRECISION,
+	createddate TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS batting_pitching_teamid_createddate ON baseballreference.batting_pitching (teamid, createddate);
+
+CREATE TABLE IF NOT EXISTS baseballreference.batting_home_away (
+	id SERIAL PRIMARY KEY,
+	teamid INTEGER NOT NULL REFERENCES baseballreference.team (id),
+	split TEXT, g INTEGER, gs INTEGER, pa INTEGER, ab INTEGER, r INTEGER, h INTEGER, twob INTEGER,
+	threeb INTEGER, hr INTEGER, rbi INTEGER, sb INTEGER, cs INTEGER, bb INTEGER, so INTEGER,
+	ba DOUBLE PRECISION, obp DOUBLE PRECISION, slg DOUBLE PRECISION, ops DOUBLE PRECISION, tb INTEGER,
+	gdp INTEGER, hbp INTEGER, sh INTEGER, sf INTEGER, ibb INTEGER, roe INTEGER, babip DOUBLE PRECISION,
+	topsplus INTEGER, sopsplus INTEGER,
+	createddate TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS batting_home_away_teamid_createddate ON baseballreference.batting_home_away (teamid, createddate);
+
+CREATE TABLE IF NOT EXISTS baseballreference.pitching_home_away (
+	id SERIAL PRIMARY KEY,
+	teamid INTEGER NOT NULL REFERENCES baseballreference.team (id),
+	split TEXT, g INTEGER, pa INTEGER, ab INTEGER, r INTEGER, h INTEGER, twob INTEGER, threeb INTEGER,
+	hr INTEGER, sb INTEGER, cs INTEGER, bb INTEGER, so INTEGER, sow DOUBLE PRECISION,
+	ba DOUBLE PRECISION, obp DOUBLE PRECISION, slg DOUBLE PRECISION, ops DOUBLE PRECISION, tb INTEGER,
+	gdp INTEGER, hbp INTEGER, sh INTEGER, sf INTEGER, ibb INTEGER, roe INTEGER, babip DOUBLE PRECISION,
+	topsplus INTEGER, sopsplus INTEGER,
+	createddate TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS pitching_home_away_teamid_createddate ON baseballreference.pitching_home_away (teamid, createddate);
+
+-- statusid: 0 success, 1 insert_error, 2 request_timeout, 3 table_timeout, 4 parse_error
+CREATE TABLE IF NOT EXISTS baseballreference.audit (
+	id SERIAL PRIMARY KEY,
+	statusid INTEGER NOT NULL,
+	teamid INTEGER REFERENCES baseballreference.team (id),
+	tablename TEXT,
+	error TEXT,
+	createddate TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
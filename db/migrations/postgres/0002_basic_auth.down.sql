@@ -0,0 +1 @@
+DROP SCHEMA basic_auth CASCADE;
@@ -1,3 +1,4 @@
+-- API users, their password hashing and the user_role function the token endpoint calls
 CREATE EXTENSION IF NOT EXISTS pgcrypto;
 CREATE SCHEMA IF NOT EXISTS basic_auth;
 
@@ -53,17 +54,3 @@ BEGIN
         );
 END
 $$ LANGUAGE plpgsql;
-
--- **DANGEROUS** - command to truncate all tables in a schema
-DO
-$$
-BEGIN
-   RAISE NOTICE '%', 
-   -- EXECUTE
-   (SELECT 'TRUNCATE TABLE ' || string_agg(oid::regclass::text, ', ') || ' CASCADE'
-    FROM   pg_class
-    WHERE  relkind = 'r' --only tables
-    AND    relnamespace = 'schema_name'::regnamespace
-   );
-END
-$$;
\ No newline at end of file
@@ -0,0 +1,19 @@
+DROP INDEX baseballreference.batting_snapshot_name;
+ALTER TABLE baseballreference.batting DROP COLUMN runid;
+DROP INDEX baseballreference.pitching_snapshot_name;
+ALTER TABLE baseballreference.pitching DROP COLUMN runid;
+DROP INDEX baseballreference.batting_splits_snapshot_split;
+ALTER TABLE baseballreference.batting_splits DROP COLUMN runid;
+DROP INDEX baseballreference.pitching_splits_snapshot_split;
+ALTER TABLE baseballreference.pitching_splits DROP COLUMN runid;
+DROP INDEX baseballreference.baserunning_snapshot_name;
+ALTER TABLE baseballreference.baserunning DROP COLUMN runid;
+DROP INDEX baseballreference.batting_pitching_snapshot_name;
+ALTER TABLE baseballreference.batting_pitching DROP COLUMN runid;
+DROP INDEX baseballreference.batting_home_away_snapshot_split;
+ALTER TABLE baseballreference.batting_home_away DROP COLUMN runid;
+DROP INDEX baseballreference.pitching_home_away_snapshot_split;
+ALTER TABLE baseballreference.pitching_home_away DROP COLUMN runid;
+ALTER TABLE baseballreference.audit DROP COLUMN runid;
+DROP TABLE baseballreference.load_run_table;
+DROP TABLE baseballreference.load_run;
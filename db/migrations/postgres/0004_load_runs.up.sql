@@ -0,0 +1,53 @@
+-- load runs: each team snapshot written by the Go loader is tagged with the run that wrote it and hidden from the
+-- API until every table of the run has landed; rows written outside a run stay visible
+CREATE TABLE baseballreference.load_run (
+	id TEXT PRIMARY KEY,
+	teamid INTEGER NOT NULL REFERENCES baseballreference.team (id),
+	createddate TIMESTAMP NOT NULL,
+	startedat TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	completedat TIMESTAMP,
+	UNIQUE (teamid, createddate)
+);
+
+CREATE TABLE baseballreference.load_run_table (
+	runid TEXT NOT NULL REFERENCES baseballreference.load_run (id),
+	tablename TEXT NOT NULL,
+	PRIMARY KEY (runid, tablename)
+);
+
+ALTER TABLE baseballreference.audit ADD COLUMN runid TEXT;
+
+-- a player, or split, appears once per team snapshot of a table; a snapshot written twice before this migration
+-- keeps the row written last
+ALTER TABLE baseballreference.batting ADD COLUMN runid TEXT;
+DELETE FROM baseballreference.batting a USING baseballreference.batting b
+	WHERE a.teamid = b.teamid AND a.createddate = b.createddate AND a.name = b.name AND a.id < b.id;
+CREATE UNIQUE INDEX batting_snapshot_name ON baseballreference.batting (teamid, createddate, name);
+ALTER TABLE baseballreference.pitching ADD COLUMN runid TEXT;
+DELETE FROM baseballreference.pitching a USING baseballreference.pitching b
+	WHERE a.teamid = b.teamid AND a.createddate = b.createddate AND a.name = b.name AND a.id < b.id;
+CREATE UNIQUE INDEX pitching_snapshot_name ON baseballreference.pitching (teamid, createddate, name);
+ALTER TABLE baseballreference.batting_splits ADD COLUMN runid TEXT;
+DELETE FROM baseballreference.batting_splits a USING baseballreference.batting_splits b
+	WHERE a.teamid = b.teamid AND a.createddate = b.createddate AND a.split = b.split AND a.id < b.id;
+CREATE UNIQUE INDEX batting_splits_snapshot_split ON baseballreference.batting_splits (teamid, createddate, split);
+ALTER TABLE baseballreference.pitching_splits ADD COLUMN runid TEXT;
+DELETE FROM baseballreference.pitching_splits a USING baseballreference.pitching_splits b
+	WHERE a.teamid = b.teamid AND a.createddate = b.createddate AND a.split = b.split AND a.id < b.id;
+CREATE UNIQUE INDEX pitching_splits_snapshot_split ON baseballreference.pitching_splits (teamid, createddate, split);
+ALTER TABLE baseballreference.baserunning ADD COLUMN runid TEXT;
+DELETE FROM baseballreference.baserunning a USING baseballreference.baserunning b
+	WHERE a.teamid = b.teamid AND a.createddate = b.createddate AND a.name = b.name AND a.id < b.id;
+CREATE UNIQUE INDEX baserunning_snapshot_name ON baseballreference.baserunning (teamid, createddate, name);
+ALTER TABLE baseballreference.batting_pitching ADD COLUMN runid TEXT;
+DELETE FROM baseballreference.batting_pitching a USING baseballreference.batting_pitching b
+	WHERE a.teamid = b.teamid AND a.createddate = b.createddate AND a.name = b.name AND a.id < b.id;
+CREATE UNIQUE INDEX batting_pitching_snapshot_name ON baseballreference.batting_pitching (teamid, createddate, name);
+ALTER TABLE baseballreference.batting_home_away ADD COLUMN runid TEXT;
+DELETE FROM baseballreference.batting_home_away a USING baseballreference.batting_home_away b
+	WHERE a.teamid = b.teamid AND a.createddate = b.createddate AND a.split = b.split AND a.id < b.id;
+CREATE UNIQUE INDEX batting_home_away_snapshot_split ON baseballreference.batting_home_away (teamid, createddate, split);
+ALTER TABLE baseballreference.pitching_home_away ADD COLUMN runid TEXT;
+DELETE FROM baseballreference.pitching_home_away a USING baseballreference.pitching_home_away b
+	WHERE a.teamid = b.teamid AND a.createddate = b.createddate AND a.split = b.split AND a.id < b.id;
+CREATE UNIQUE INDEX pitching_home_away_snapshot_split ON baseballreference.pitching_home_away (teamid, createddate, split);
@@ -0,0 +1,108 @@
+-- the team, stat and audit tables the scraper writes and the API reads; SQLite has no schemas, so they live in the
+-- main database. createddate defaults to the current UTC time in the layout the driver binds time.Time values with,
+-- so stored and bound timestamps compare in order
+CREATE TABLE team (
+	id INTEGER PRIMARY KEY,
+	teamname TEXT NOT NULL,
+	teamabbrev TEXT NOT NULL UNIQUE
+);
+
+CREATE TABLE batting (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	teamid INTEGER NOT NULL REFERENCES team (id),
+	rk INTEGER, pos TEXT, name TEXT, age INTEGER, g INTEGER, pa INTEGER, ab INTEGER, r INTEGER,
+	h INTEGER, twob INTEGER, threeb INTEGER, hr INTEGER, rbi INTEGER, sb INTEGER, cs INTEGER,
+	bb INTEGER, so INTEGER, ba REAL, obp REAL, slg REAL, ops REAL, opsplus INTEGER, tb INTEGER,
+	gdp INTEGER, hbp INTEGER, sh INTEGER, sf INTEGER, ibb INTEGER,
+	createddate TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+CREATE INDEX batting_teamid_createddate ON batting (teamid, createddate);
+
+CREATE TABLE pitching (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	teamid INTEGER NOT NULL REFERENCES team (id),
+	rk INTEGER, pos TEXT, name TEXT, age INTEGER, w INTEGER, l INTEGER, wl REAL, era REAL, g INTEGER,
+	gs INTEGER, gf INTEGER, cg INTEGER, sho INTEGER, sv INTEGER, ip REAL, h INTEGER, r INTEGER,
+	er INTEGER, hr INTEGER, bb INTEGER, ibb INTEGER, so INTEGER, hbp INTEGER, bk INTEGER, wp INTEGER,
+	bf INTEGER, eraplus INTEGER, fip REAL, whip REAL, h9 REAL, hr9 REAL, bb9 REAL, so9 REAL, sow REAL,
+	createddate TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+CREATE INDEX pitching_teamid_createddate ON pitching (teamid, createddate);
+
+CREATE TABLE batting_splits (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	teamid INTEGER NOT NULL REFERENCES team (id),
+	split TEXT, g INTEGER, gs INTEGER, pa INTEGER, ab INTEGER, r INTEGER, h INTEGER, twob INTEGER,
+	threeb INTEGER, hr INTEGER, rbi INTEGER, sb INTEGER, cs INTEGER, bb INTEGER, so INTEGER, ba REAL,
+	obp REAL, slg REAL, ops REAL, tb INTEGER, gdp INTEGER, hbp INTEGER, sh INTEGER, sf INTEGER,
+	ibb INTEGER, roe INTEGER, babip REAL, topsplus INTEGER, sopsplus INTEGER,
+	createddate TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+CREATE INDEX batting_splits_teamid_createddate ON batting_splits (teamid, createddate);
+
+CREATE TABLE pitching_splits (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	teamid INTEGER NOT NULL REFERENCES team (id),
+	split TEXT, g INTEGER, pa INTEGER, ab INTEGER, r INTEGER, h INTEGER, twob INTEGER, threeb INTEGER,
+	hr INTEGER, sb INTEGER, cs INTEGER, bb INTEGER, so INTEGER, sow REAL, ba REAL, obp REAL, slg REAL,
+	ops REAL, tb INTEGER, gdp INTEGER, hbp INTEGER, sh INTEGER, sf INTEGER, ibb INTEGER, roe INTEGER,
+	babip REAL, topsplus INTEGER, sopsplus INTEGER,
+	createddate TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+CREATE INDEX pitching_splits_teamid_createddate ON pitching_splits (teamid, createddate);
+
+CREATE TABLE baserunning (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	teamid INTEGER NOT NULL REFERENCES team (id),
+	name TEXT, age INTEGER, pa INTEGER, roe INTEGER, xi INTEGER, rspct TEXT, sbo INTEGER, sb INTEGER,
+	cs INTEGER, sbpct TEXT, sb2 INTEGER, cs2 INTEGER, sb3 INTEGER, cs3 INTEGER, sbh INTEGER,
+	csh INTEGER, po INTEGER, pcs INTEGER, oob INTEGER, oob1 INTEGER, oob2 INTEGER, oob3 INTEGER,
+	oobhm INTEGER, bt INTEGER, xbtpct TEXT, firsts INTEGER, firsts2 INTEGER, firsts3 INTEGER,
+	firstd INTEGER, firstd3 INTEGER, firstdh INTEGER, seconds INTEGER, seconds3 INTEGER,
+	secondsh INTEGER,
+	createddate TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+CREATE INDEX baserunning_teamid_createddate ON baserunning (teamid, createddate);
+
+CREATE TABLE batting_pitching (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	teamid INTEGER NOT NULL REFERENCES team (id),
+	rk INTEGER, name TEXT, age INTEGER, ip REAL, g INTEGER, pa INTEGER, ab INTEGER, r INTEGER,
+	h INTEGER, twob INTEGER, threeb INTEGER, hr INTEGER, sb INTEGER, cs INTEGER, bb INTEGER, so INTEGER,
+	ba REAL, obp REAL, slg REAL, ops REAL, tb INTEGER, gdp INTEGER, hbp INTEGER, sh INTEGER, sf INTEGER,
+	ibb INTEGER, roe INTEGER, babip REAL,
+	createddate TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+CREATE INDEX batting_pitching_teamid_createddate ON batting_pitching (teamid, createddate);
+
+CREATE TABLE batting_home_away (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	teamid INTEGER NOT NULL REFERENCES team (id),
+	split TEXT, g INTEGER, gs INTEGER, pa INTEGER, ab INTEGER, r INTEGER, h INTEGER, twob INTEGER,
+	threeb INTEGER, hr INTEGER, rbi INTEGER, sb INTEGER, cs INTEGER, bb INTEGER, so INTEGER, ba REAL,
+	obp REAL, slg REAL, ops REAL, tb INTEGER, gdp INTEGER, hbp INTEGER, sh INTEGER, sf INTEGER,
+	ibb INTEGER, roe INTEGER, babip REAL, topsplus INTEGER, sopsplus INTEGER,
+	createddate TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+CREATE INDEX batting_home_away_teamid_createddate ON batting_home_away (teamid, createddate);
+
+CREATE TABLE pitching_home_away (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	teamid INTEGER NOT NULL REFERENCES team (id),
+	split TEXT, g INTEGER, pa INTEGER, ab INTEGER, r INTEGER, h INTEGER, twob INTEGER, threeb INTEGER,
+	hr INTEGER, sb INTEGER, cs INTEGER, bb INTEGER, so INTEGER, sow REAL, ba REAL, obp REAL, slg REAL,
+	ops REAL, tb INTEGER, gdp INTEGER, hbp INTEGER, sh INTEGER, sf INTEGER, ibb INTEGER, roe INTEGER,
+	babip REAL, topsplus INTEGER, sopsplus INTEGER,
+	createddate TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+CREATE INDEX pitching_home_away_teamid_createddate ON pitching_home_away (teamid, createddate);
+
+-- statusid: 0 success, 1 insert_error, 2 request_timeout, 3 table_timeout, 4 parse_error
+CREATE TABLE audit (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	statusid INTEGER NOT NULL,
+	teamid INTEGER REFERENCES team (id),
+	tablename TEXT,
+	error TEXT,
+	createddate TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
@@ -0,0 +1,9 @@
+-- API users; pass holds bcrypt hashes as basic_auth.users does in Postgres and is checked with the crypt function
+-- the driver registers on each connection
+CREATE TABLE users (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	email TEXT NOT NULL UNIQUE,
+	pass TEXT NOT NULL,
+	role TEXT NOT NULL,
+	verified INTEGER NOT NULL DEFAULT 0
+);
@@ -0,0 +1,19 @@
+DROP INDEX batting_snapshot_name;
+ALTER TABLE batting DROP COLUMN runid;
+DROP INDEX pitching_snapshot_name;
+ALTER TABLE pitching DROP COLUMN runid;
+DROP INDEX batting_splits_snapshot_split;
+ALTER TABLE batting_splits DROP COLUMN runid;
+DROP INDEX pitching_splits_snapshot_split;
+ALTER TABLE pitching_splits DROP COLUMN runid;
+DROP INDEX baserunning_snapshot_name;
+ALTER TABLE baserunning DROP COLUMN runid;
+DROP INDEX batting_pitching_snapshot_name;
+ALTER TABLE batting_pitching DROP COLUMN runid;
+DROP INDEX batting_home_away_snapshot_split;
+ALTER TABLE batting_home_away DROP COLUMN runid;
+DROP INDEX pitching_home_away_snapshot_split;
+ALTER TABLE pitching_home_away DROP COLUMN runid;
+ALTER TABLE audit DROP COLUMN runid;
+DROP TABLE load_run_table;
+DROP TABLE load_run;
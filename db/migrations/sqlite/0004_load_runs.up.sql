@@ -0,0 +1,69 @@
+-- load runs: each team snapshot written by the Go loader is tagged with the run that wrote it and hidden from the
+-- API until every table of the run has landed; rows written outside a run stay visible
+CREATE TABLE load_run (
+	id TEXT PRIMARY KEY,
+	teamid INTEGER NOT NULL REFERENCES team (id),
+	createddate TIMESTAMP NOT NULL,
+	startedat TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	completedat TIMESTAMP,
+	UNIQUE (teamid, createddate)
+);
+
+CREATE TABLE load_run_table (
+	runid TEXT NOT NULL REFERENCES load_run (id),
+	tablename TEXT NOT NULL,
+	PRIMARY KEY (runid, tablename)
+);
+
+ALTER TABLE audit ADD COLUMN runid TEXT;
+
+-- a player, or split, appears once per team snapshot of a table; a snapshot written twice before this migration
+-- keeps the row written last
+ALTER TABLE batting ADD COLUMN runid TEXT;
+DELETE FROM batting WHERE EXISTS (
+	SELECT 1 FROM batting b
+	WHERE b.teamid = batting.teamid AND b.createddate = batting.createddate AND b.name = batting.name AND b.id > batting.id
+);
+CREATE UNIQUE INDEX batting_snapshot_name ON batting (teamid, createddate, name);
+ALTER TABLE pitching ADD COLUMN runid TEXT;
+DELETE FROM pitching WHERE EXISTS (
+	SELECT 1 FROM pitching b
+	WHERE b.teamid = pitching.teamid AND b.createddate = pitching.createddate AND b.name = pitching.name AND b.id > pitching.id
+);
+CREATE UNIQUE INDEX pitching_snapshot_name ON pitching (teamid, createddate, name);
+ALTER TABLE batting_splits ADD COLUMN runid TEXT;
+DELETE FROM batting_splits WHERE EXISTS (
+	SELECT 1 FROM batting_splits b
+	WHERE b.teamid = batting_splits.teamid AND b.createddate = batting_splits.createddate AND b.split = batting_splits.split AND b.id > batting_splits.id
+);
+CREATE UNIQUE INDEX batting_splits_snapshot_split ON batting_splits (teamid, createddate, split);
+ALTER TABLE pitching_splits ADD COLUMN runid TEXT;
+DELETE FROM pitching_splits WHERE EXISTS (
+	SELECT 1 FROM pitching_splits b
+	WHERE b.teamid = pitching_splits.teamid AND b.createddate = pitching_splits.createddate AND b.split = pitching_splits.split AND b.id > pitching_splits.id
+);
+CREATE UNIQUE INDEX pitching_splits_snapshot_split ON pitching_splits (teamid, createddate, split);
+ALTER TABLE baserunning ADD COLUMN runid TEXT;
+DELETE FROM baserunning WHERE EXISTS (
+	SELECT 1 FROM baserunning b
+	WHERE b.teamid = baserunning.teamid AND b.createddate = baserunning.createddate AND b.name = baserunning.name AND b.id > baserunning.id
+);
+CREATE UNIQUE INDEX baserunning_snapshot_name ON baserunning (teamid, createddate, name);
+ALTER TABLE batting_pitching ADD COLUMN runid TEXT;
+DELETE FROM batting_pitching WHERE EXISTS (
+	SELECT 1 FROM batting_pitching b
+	WHERE b.teamid = batting_pitching.teamid AND b.createddate = batting_pitching.createddate AND b.name = batting_pitching.name AND b.id > batting_pitching.id
+);
+CREATE UNIQUE INDEX batting_pitching_snapshot_name ON batting_pitching (teamid, createddate, name);
+ALTER TABLE batting_home_away ADD COLUMN runid TEXT;
+DELETE FROM batting_home_away WHERE EXISTS (
+	SELECT 1 FROM batting_home_away b
+	WHERE b.teamid = batting_home_away.teamid AND b.createddate = batting_home_away.createddate AND b.split = batting_home_away.split AND b.id > batting_home_away.id
+);
+CREATE UNIQUE INDEX batting_home_away_snapshot_split ON batting_home_away (teamid, createddate, split);
+ALTER TABLE pitching_home_away ADD COLUMN runid TEXT;
+DELETE FROM pitching_home_away WHERE EXISTS (
+	SELECT 1 FROM pitching_home_away b
+	WHERE b.teamid = pitching_home_away.teamid AND b.createddate = pitching_home_away.createddate AND b.split = pitching_home_away.split AND b.id > pitching_home_away.id
+);
+CREATE UNIQUE INDEX pitching_home_away_snapshot_split ON pitching_home_away (teamid, createddate, split);
@@ -0,0 +1,13 @@
+DROP TABLE baseballreference.audit;
+DROP TABLE baseballreference.pitching_home_away;
+DROP TABLE baseballreference.batting_home_away;
+DROP TABLE baseballreference.batting_pitching;
+DROP TABLE baseballreference.baserunning;
+DROP TABLE baseballreference.pitching_splits;
+DROP TABLE baseballreference.batting_splits;
+DROP TABLE baseballreference.pitching;
+DROP TABLE baseballreference.batting;
+DROP TABLE baseballreference.team;
+GO
+
+DROP SCHEMA baseballreference;
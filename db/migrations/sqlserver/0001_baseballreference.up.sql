@@ -0,0 +1,118 @@
+-- the team, stat and audit tables the scraper writes and the API reads, in the baseballreference schema as on
+-- Postgres; CREATE SCHEMA must be alone in its batch, hence the GO separator
+CREATE SCHEMA baseballreference;
+GO
+
+CREATE TABLE baseballreference.team (
+	id INT IDENTITY PRIMARY KEY,
+	teamname NVARCHAR(255) NOT NULL,
+	teamabbrev NVARCHAR(255) NOT NULL UNIQUE
+);
+
+CREATE TABLE baseballreference.batting (
+	id INT IDENTITY PRIMARY KEY,
+	teamid INT NOT NULL REFERENCES baseballreference.team (id),
+	rk INT, pos NVARCHAR(255), name NVARCHAR(255), age INT, g INT, pa INT, ab INT, r INT,
+	h INT, twob INT, threeb INT, hr INT, rbi INT, sb INT, cs INT,
+	bb INT, so INT, ba FLOAT, obp FLOAT, slg FLOAT,
+	ops FLOAT, opsplus INT, tb INT, gdp INT, hbp INT, sh INT, sf INT,
+	ibb INT,
+	createddate DATETIME2 NOT NULL DEFAULT SYSUTCDATETIME()
+);
+CREATE INDEX batting_teamid_createddate ON baseballreference.batting (teamid, createddate);
+
+CREATE TABLE baseballreference.pitching (
+	id INT IDENTITY PRIMARY KEY,
+	teamid INT NOT NULL REFERENCES baseballreference.team (id),
+	rk INT, pos NVARCHAR(255), name NVARCHAR(255), age INT, w INT, l INT, wl FLOAT,
+	era FLOAT, g INT, gs INT, gf INT, cg INT, sho INT, sv INT,
+	ip FLOAT, h INT, r INT, er INT, hr INT, bb INT, ibb INT,
+	so INT, hbp INT, bk INT, wp INT, bf INT, eraplus INT, fip FLOAT,
+	whip FLOAT, h9 FLOAT, hr9 FLOAT, bb9 FLOAT,
+	so9 FLOAT, sow FLOAT,
+	createddate DATETIME2 NOT NULL DEFAULT SYSUTCDATETIME()
+);
+CREATE INDEX pitching_teamid_createddate ON baseballreference.pitching (teamid, createddate);
+
+CREATE TABLE baseballreference.batting_splits (
+	id INT IDENTITY PRIMARY KEY,
+	teamid INT NOT NULL REFERENCES baseballreference.team (id),
+	split NVARCHAR(255), g INT, gs INT, pa INT, ab INT, r INT, h INT, twob INT,
+	threeb INT, hr INT, rbi INT, sb INT, cs INT, bb INT, so INT,
+	ba FLOAT, obp FLOAT, slg FLOAT, ops FLOAT, tb INT,
+	gdp INT, hbp INT, sh INT, sf INT, ibb INT, roe INT, babip FLOAT,
+	topsplus INT, sopsplus INT,
+	createddate DATETIME2 NOT NULL DEFAULT SYSUTCDATETIME()
+);
+CREATE INDEX batting_splits_teamid_createddate ON baseballreference.batting_splits (teamid, createddate);
+
+CREATE TABLE baseballreference.pitching_splits (
+	id INT IDENTITY PRIMARY KEY,
+	teamid INT NOT NULL REFERENCES baseballreference.team (id),
+	split NVARCHAR(255), g INT, pa INT, ab INT, r INT, h INT, twob INT, threeb INT,
+	hr INT, sb INT, cs INT, bb INT, so INT, sow FLOAT,
+	ba FLOAT, obp FLOAT, slg FLOAT, ops FLOAT, tb INT,
+	gdp INT, hbp INT, sh INT, sf INT, ibb INT, roe INT, babip FLOAT,
+	topsplus INT, sopsplus INT,
+	createddate DATETIME2 NOT NULL DEFAULT SYSUTCDATETIME()
+);
+CREATE INDEX pitching_splits_teamid_createddate ON baseballreference.pitching_splits (teamid, createddate);
+
+CREATE TABLE baseballreference.baserunning (
+	id INT IDENTITY PRIMARY KEY,
+	teamid INT NOT NULL REFERENCES baseballreference.team (id),
+	name NVARCHAR(255), age INT, pa INT, roe INT, xi INT, rspct NVARCHAR(255), sbo INT, sb INT,
+	cs INT, sbpct NVARCHAR(255), sb2 INT, cs2 INT, sb3 INT, cs3 INT, sbh INT,
+	csh INT, po INT, pcs INT, oob INT, oob1 INT, oob2 INT, oob3 INT,
+	oobhm INT, bt INT, xbtpct NVARCHAR(255), firsts INT, firsts2 INT, firsts3 INT,
+	firstd INT, firstd3 INT, firstdh INT, seconds INT, seconds3 INT,
+	secondsh INT,
+	createddate DATETIME2 NOT NULL DEFAULT SYSUTCDATETIME()
+);
+CREATE INDEX baserunning_teamid_createddate ON baseballreference.baserunning (teamid, createddate);
+
+CREATE TABLE baseballreference.batting_pitching (
+	id INT IDENTITY PRIMARY KEY,
+	teamid INT NOT NULL REFERENCES baseballreference.team (id),
+	rk INT, name NVARCHAR(255), age INT, ip FLOAT, g INT, pa INT, ab INT,
+	r INT, h INT, twob INT, threeb INT, hr INT, sb INT, cs INT, bb INT,
+	so INT, ba FLOAT, obp FLOAT, slg FLOAT, ops FLOAT,
+	tb INT, gdp INT, hbp INT, sh INT, sf INT, ibb INT, roe INT,
+	babip FLOAT,
+	createddate DATETIME2 NOT NULL DEFAULT SYSUTCDATETIME()
+);
+CREATE INDEX batting_pitching_teamid_createddate ON baseballreference.batting_pitching (teamid, createddate);
+
+CREATE TABLE baseballreference.batting_home_away (
+	id INT IDENTITY PRIMARY KEY,
+	teamid INT NOT NULL REFERENCES baseballreference.team (id),
+	split NVARCHAR(255), g INT, gs INT, pa INT, ab INT, r INT, h INT, twob INT,
+	threeb INT, hr INT, rbi INT, sb INT, cs INT, bb INT, so INT,
+	ba FLOAT, obp FLOAT, slg FLOAT, ops FLOAT, tb INT,
+	gdp INT, hbp INT, sh INT, sf INT, ibb INT, roe INT, babip FLOAT,
+	topsplus INT, sopsplus INT,
+	createddate DATETIME2 NOT NULL DEFAULT SYSUTCDATETIME()
+);
+CREATE INDEX batting_home_away_teamid_createddate ON baseballreference.batting_home_away (teamid, createddate);
+
+CREATE TABLE baseballreference.pitching_home_away (
+	id INT IDENTITY PRIMARY KEY,
+	teamid INT NOT NULL REFERENCES baseballreference.team (id),
+	split NVARCHAR(255), g INT, pa INT, ab INT, r INT, h INT, twob INT, threeb INT,
+	hr INT, sb INT, cs INT, bb INT, so INT, sow FLOAT,
+	ba FLOAT, obp FLOAT, slg FLOAT, ops FLOAT, tb INT,
+	gdp INT, hbp INT, sh INT, sf INT, ibb INT, roe INT, babip FLOAT,
+	topsplus INT, sopsplus INT,
+	createddate DATETIME2 NOT NULL DEFAULT SYSUTCDATETIME()
+);
+CREATE INDEX pitching_home_away_teamid_createddate ON baseballreference.pitching_home_away (teamid, createddate);
+
+-- statusid: 0 success, 1 insert_error, 2 request_timeout, 3 table_timeout, 4 parse_error
+CREATE TABLE baseballreference.audit (
+	id INT IDENTITY PRIMARY KEY,
+	statusid INT NOT NULL,
+	teamid INT REFERENCES baseballreference.team (id),
+	tablename NVARCHAR(255),
+	error NVARCHAR(MAX),
+	createddate DATETIME2 NOT NULL DEFAULT SYSUTCDATETIME()
+);
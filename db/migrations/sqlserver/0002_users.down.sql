@@ -0,0 +1,4 @@
+DROP TABLE basic_auth.users;
+GO
+
+DROP SCHEMA basic_auth;
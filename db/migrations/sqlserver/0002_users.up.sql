@@ -0,0 +1,12 @@
+-- API users in the basic_auth schema as on Postgres; pass holds bcrypt hashes. SQL Server has no bcrypt, so the API
+-- reads the hash of a user and checks the password itself
+CREATE SCHEMA basic_auth;
+GO
+
+CREATE TABLE basic_auth.users (
+	id INT IDENTITY PRIMARY KEY,
+	email NVARCHAR(255) NOT NULL UNIQUE,
+	pass NVARCHAR(511) NOT NULL,
+	role NVARCHAR(511) NOT NULL,
+	verified BIT NOT NULL DEFAULT 0
+);
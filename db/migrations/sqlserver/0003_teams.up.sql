@@ -0,0 +1,37 @@
+-- the 30 franchises under their Baseball-Reference abbreviations, which the scraper builds team page urls from;
+-- teams already present keep their ids
+INSERT INTO baseballreference.team (teamname, teamabbrev)
+SELECT v.teamname, v.teamabbrev
+FROM (VALUES
+	('Arizona Diamondbacks', 'ARI'),
+	('Atlanta Braves', 'ATL'),
+	('Baltimore Orioles', 'BAL'),
+	('Boston Red Sox', 'BOS'),
+	('Chicago Cubs', 'CHC'),
+	('Chicago White Sox', 'CHW'),
+	('Cincinnati Reds', 'CIN'),
+	('Cleveland Indians', 'CLE'),
+	('Colorado Rockies', 'COL'),
+	('Detroit Tigers', 'DET'),
+	('Houston Astros', 'HOU'),
+	('Kansas City Royals', 'KCR'),
+	('Los Angeles Angels', 'LAA'),
+	('Los Angeles Dodgers', 'LAD'),
+	('Miami Marlins', 'MIA'),
+	('Milwaukee Brewers', 'MIL'),
+	('Minnesota Twins', 'MIN'),
+	('New York Mets', 'NYM'),
+	('New York Yankees', 'NYY'),
+	('Oakland Athletics', 'OAK'),
+	('Philadelphia Phillies', 'PHI'),
+	('Pittsburgh Pirates', 'PIT'),
+	('San Diego Padres', 'SDP'),
+	('Seattle Mariners', 'SEA'),
+	('San Francisco Giants', 'SFG'),
+	('St. Louis Cardinals', 'STL'),
+	('Tampa Bay Rays', 'TBR'),
+	('Texas Rangers', 'TEX'),
+	('Toronto Blue Jays', 'TOR'),
+	('Washington Nationals', 'WSN')
+) v (teamname, teamabbrev)
+WHERE NOT EXISTS (SELECT 1 FROM baseballreference.team t WHERE t.teamabbrev = v.teamabbrev);
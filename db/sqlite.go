@@ -0,0 +1,31 @@
+package db
+
+import (
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/mattn/go-sqlite3"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// sqliteDriver is the Rdbms name of the SQLite driver; its connections register crypt so API users can be
+// looked up in SQL the way basic_auth.user_role does in Postgres
+const sqliteDriver = "sqlite"
+
+func init() {
+	sqlx.BindDriver(sqliteDriver, sqlx.QUESTION)
+	sql.Register(sqliteDriver, &sqlite3.SQLiteDriver{
+		ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+			return conn.RegisterFunc("crypt", crypt, true)
+		},
+	})
+}
+
+// crypt stands in for pgcrypto's crypt(pass, users.pass) comparison: it returns the bcrypt hash when pass matches
+// it and "" otherwise, so `users.pass = crypt(pass, users.pass)` holds only for the right password
+func crypt(pass, hash string) string {
+	if bcrypt.CompareHashAndPassword([]byte(hash), []byte(pass)) != nil {
+		return ""
+	}
+	return hash
+}
@@ -0,0 +1,155 @@
+package ingest
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"sports-data-api/db"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// audit statusid values, as the scraper writes them
+const (
+	statusSuccess      = 0
+	statusInsertError  = 1
+	statusTableMissing = 3
+	statusParseError   = 4
+)
+
+// Ingester loads the tables of saved Baseball-Reference pages into the baseballreference tables, recording each
+// load in the audit table
+type Ingester struct {
+	Db     *sqlx.DB
+	schema string
+}
+
+// NewIngester creates an ingester over an open connection pool
+func NewIngester(pool *sqlx.DB) *Ingester {
+	return &Ingester{Db: pool, schema: db.Schema(pool.DriverName())}
+}
+
+// TeamID returns the id of a team abbreviation
+func (in *Ingester) TeamID(ctx context.Context, abbrev string) (int, error) {
+	var id int
+	query := in.Db.Rebind(fmt.Sprintf("SELECT id FROM %steam WHERE teamabbrev = ?", in.schema))
+	err := in.Db.GetContext(ctx, &id, query, strings.ToUpper(abbrev))
+	if err == sql.ErrNoRows {
+		return 0, errors.Errorf("unknown team: %s", abbrev)
+	}
+	return id, errors.Wrap(err, "error reading team")
+}
+
+// IngestPage loads every table of a saved page for a team as the snapshot taken at createddate. Each table is
+// inserted in its own transaction and audited; a missing or failing table does not stop the others, and the first
+// error is returned once all have been tried. Rows are only ever added: on a postgres or sqlite schema migrated
+// with the load runs, the unique snapshot indexes refuse a table whose snapshot already holds one of its players,
+// so ingesting a page twice fails and is audited as an insert error; LoadSnapshot replaces a snapshot instead
+func (in *Ingester) IngestPage(ctx context.Context, page Page, teamid int, r io.Reader, createddate time.Time) error {
+	tables, parseErr := ParseTables(r)
+	var first error
+	for _, source := range page.Sources {
+		status, err := statusSuccess, parseErr
+		if err != nil {
+			status = statusParseError
+		} else if t, ok := tables[source.HTMLID]; !ok {
+			status, err = statusTableMissing, errors.Errorf("html table - %s", source.HTMLID)
+		} else if err = in.insert(ctx, source.Table, teamid, t, createddate); err != nil {
+			status = statusInsertError
+		}
+		if auditErr := in.audit(ctx, in.Db, "", status, teamid, source.Table, err, createddate); auditErr != nil && err == nil {
+			err = auditErr
+		}
+		if err != nil && first == nil {
+			first = errors.Wrapf(err, "error ingesting %s", source.Table)
+		}
+	}
+	return first
+}
+
+// columns lists the columns of a baseballreference table
+func (in *Ingester) columns(ctx context.Context, table string) (map[string]bool, error) {
+	rows, err := in.Db.QueryContext(ctx, fmt.Sprintf("SELECT * FROM %s%s WHERE 1 = 0", in.schema, table))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading %s columns", table)
+	}
+	defer rows.Close()
+	names, err := rows.Columns()
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading %s columns", table)
+	}
+	columns := make(map[string]bool, len(names))
+	for _, name := range names {
+		columns[strings.ToLower(name)] = true
+	}
+	return columns, nil
+}
+
+// reserved are the columns the loaders set rather than read from a page
+var reserved = map[string]bool{"id": true, "teamid": true, "createddate": true, "runid": true}
+
+// insert writes the rows of t to a table in one transaction; page columns the table does not have, such as the
+// awards and WAR columns of recent seasons, are left out
+func (in *Ingester) insert(ctx context.Context, table string, teamid int, t *Table, createddate time.Time) error {
+	known, err := in.columns(ctx, table)
+	if err != nil {
+		return err
+	}
+	columns := []string{"teamid", "createddate"}
+	var indexes []int
+	for i, column := range t.Columns {
+		if known[column] && !reserved[column] {
+			columns = append(columns, column)
+			indexes = append(indexes, i)
+		}
+	}
+	query := in.Db.Rebind(fmt.Sprintf(
+		"INSERT INTO %s%s (%s) VALUES (%s)",
+		in.schema, table, strings.Join(columns, ", "), strings.TrimSuffix(strings.Repeat("?, ", len(columns)), ", "),
+	))
+	tx, err := in.Db.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "error starting insert")
+	}
+	defer tx.Rollback()
+	stmt, err := tx.PreparexContext(ctx, query)
+	if err != nil {
+		return errors.Wrapf(err, "error preparing %s insert", table)
+	}
+	defer stmt.Close()
+	args := make([]interface{}, len(columns))
+	args[0], args[1] = teamid, createddate
+	for _, row := range t.Rows {
+		for j, i := range indexes {
+			args[j+2] = row[i]
+		}
+		if _, err := stmt.ExecContext(ctx, args...); err != nil {
+			return errors.Wrapf(err, "error inserting into %s", table)
+		}
+	}
+	return errors.Wrapf(tx.Commit(), "error committing %s insert", table)
+}
+
+// audit records the outcome of loading a table, tagged with the load run when there is one
+func (in *Ingester) audit(ctx context.Context, exec sqlx.ExecerContext, runid string, status, teamid int, table string, loadErr error, createddate time.Time) error {
+	var message sql.NullString
+	if loadErr != nil {
+		message = sql.NullString{String: loadErr.Error(), Valid: true}
+	}
+	columns := "statusid, teamid, tablename, error, createddate"
+	args := []interface{}{status, teamid, table, message, createddate}
+	if runid != "" {
+		columns += ", runid"
+		args = append(args, runid)
+	}
+	query := in.Db.Rebind(fmt.Sprintf(
+		"INSERT INTO %saudit (%s) VALUES (%s)",
+		in.schema, columns, strings.TrimSuffix(strings.Repeat("?, ", len(args)), ", "),
+	))
+	_, err := exec.ExecContext(ctx, query, args...)
+	return errors.Wrap(err, "error writing audit row")
+}
@@ -0,0 +1,234 @@
+package ingest
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// Run is a load of one team's snapshot. Loading again under the same run replaces its rows, and the API shows the
+// snapshot once every table of every page has landed under it; runs need the load_run tables of the postgres or
+// sqlite migrations and the ON CONFLICT upserts only those databases have
+type Run struct {
+	ID          string
+	Teamid      int
+	Createddate time.Time
+}
+
+// NewRun starts a run of a team's snapshot taken now
+func NewRun(teamid int) (Run, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return Run{}, errors.Wrap(err, "error generating run id")
+	}
+	return Run{ID: hex.EncodeToString(b), Teamid: teamid, Createddate: time.Now().UTC().Truncate(time.Second)}, nil
+}
+
+// runDrivers are the drivers load runs are supported on
+var runDrivers = map[string]bool{"postgres": true, "sqlite": true}
+
+// checkRuns rejects load runs on a driver without the load_run tables and ON CONFLICT
+func (in *Ingester) checkRuns() error {
+	if driver := in.Db.DriverName(); !runDrivers[driver] {
+		return errors.Errorf("load runs are not supported on %s; use postgres or sqlite", driver)
+	}
+	return nil
+}
+
+// Run reads a recorded run so that an interrupted load can be retried under it
+func (in *Ingester) Run(ctx context.Context, id string) (Run, error) {
+	var run Run
+	if err := in.checkRuns(); err != nil {
+		return run, err
+	}
+	query := in.Db.Rebind(fmt.Sprintf("SELECT id, teamid, createddate FROM %sload_run WHERE id = ?", in.schema))
+	err := in.Db.QueryRowxContext(ctx, query, id).Scan(&run.ID, &run.Teamid, &run.Createddate)
+	if err == sql.ErrNoRows {
+		return run, errors.Errorf("unknown run: %s", id)
+	}
+	return run, errors.Wrap(err, "error reading run")
+}
+
+// LoadSnapshot loads the saved pages of a run, keyed by page name, in a single transaction: every table is parsed
+// before any is written, a row replaces the row of the same player or split in the snapshot, and the run is marked
+// complete once all tables of all Pages have landed under it. Nothing is written when any table fails, and the
+// failure is audited against the run
+func (in *Ingester) LoadSnapshot(ctx context.Context, run Run, pages map[string]io.Reader) error {
+	type load struct {
+		source Source
+		table  *Table
+		known  map[string]bool
+	}
+	if err := in.checkRuns(); err != nil {
+		return err
+	}
+	names := make([]string, 0, len(pages))
+	for name := range pages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var loads []load
+	for _, name := range names {
+		page, ok := Pages[name]
+		if !ok {
+			return errors.Errorf("unknown page: %s", name)
+		}
+		tables, err := ParseTables(pages[name])
+		if err != nil {
+			for _, source := range page.Sources[1:] {
+				if auditErr := in.audit(ctx, in.Db, run.ID, statusParseError, run.Teamid, source.Table, err, run.Createddate); auditErr != nil {
+					return auditErr
+				}
+			}
+			return in.fail(ctx, run, statusParseError, page.Sources[0].Table, err)
+		}
+		for _, source := range page.Sources {
+			t, ok := tables[source.HTMLID]
+			if !ok {
+				return in.fail(ctx, run, statusTableMissing, source.Table, errors.Errorf("html table - %s", source.HTMLID))
+			}
+			known, err := in.columns(ctx, source.Table)
+			if err != nil {
+				return err
+			}
+			loads = append(loads, load{source: source, table: t, known: known})
+		}
+	}
+	tx, err := in.Db.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "error starting load")
+	}
+	defer tx.Rollback()
+	if err := in.startRun(ctx, tx, run); err != nil {
+		return err
+	}
+	for _, l := range loads {
+		if err := in.upsert(ctx, tx, run, l.source, l.table, l.known); err != nil {
+			tx.Rollback()
+			return in.fail(ctx, run, statusInsertError, l.source.Table, err)
+		}
+		query := tx.Rebind(fmt.Sprintf(
+			"INSERT INTO %sload_run_table (runid, tablename) VALUES (?, ?) ON CONFLICT DO NOTHING", in.schema,
+		))
+		if _, err := tx.ExecContext(ctx, query, run.ID, l.source.Table); err != nil {
+			return errors.Wrapf(err, "error recording %s", l.source.Table)
+		}
+		if err := in.audit(ctx, tx, run.ID, statusSuccess, run.Teamid, l.source.Table, nil, run.Createddate); err != nil {
+			return err
+		}
+	}
+	if err := in.completeRun(ctx, tx, run); err != nil {
+		return err
+	}
+	return errors.Wrap(tx.Commit(), "error committing load")
+}
+
+// fail audits a table that stopped a run's load and returns the error
+func (in *Ingester) fail(ctx context.Context, run Run, status int, table string, err error) error {
+	if auditErr := in.audit(ctx, in.Db, run.ID, status, run.Teamid, table, err, run.Createddate); auditErr != nil {
+		return auditErr
+	}
+	return errors.Wrapf(err, "error loading %s", table)
+}
+
+// startRun records a run, or checks that a retried run is the same snapshot; a snapshot belongs to a single run
+func (in *Ingester) startRun(ctx context.Context, tx *sqlx.Tx, run Run) error {
+	query := tx.Rebind(fmt.Sprintf(
+		"INSERT INTO %sload_run (id, teamid, createddate) VALUES (?, ?, ?) ON CONFLICT DO NOTHING", in.schema,
+	))
+	if _, err := tx.ExecContext(ctx, query, run.ID, run.Teamid, run.Createddate); err != nil {
+		return errors.Wrap(err, "error recording run")
+	}
+	var recorded Run
+	query = tx.Rebind(fmt.Sprintf("SELECT id, teamid, createddate FROM %sload_run WHERE id = ?", in.schema))
+	err := tx.QueryRowxContext(ctx, query, run.ID).Scan(&recorded.ID, &recorded.Teamid, &recorded.Createddate)
+	if err == sql.ErrNoRows {
+		return errors.Errorf("snapshot of team %d at %s is loaded by another run", run.Teamid, run.Createddate.Format(time.RFC3339))
+	}
+	if err != nil {
+		return errors.Wrap(err, "error reading run")
+	}
+	if recorded.Teamid != run.Teamid || !recorded.Createddate.Equal(run.Createddate) {
+		return errors.Errorf("run %s is the snapshot of team %d at %s", run.ID, recorded.Teamid, recorded.Createddate.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// upsert writes the rows of t under a run, replacing the row with the same key in the snapshot; rows without a key
+// are rejected
+func (in *Ingester) upsert(ctx context.Context, tx *sqlx.Tx, run Run, source Source, t *Table, known map[string]bool) error {
+	columns := []string{"runid", "teamid", "createddate"}
+	var (
+		indexes []int
+		updates = []string{"runid = excluded.runid"}
+		key     = -1
+	)
+	for i, column := range t.Columns {
+		if known[column] && !reserved[column] {
+			if column == source.Key {
+				key = i
+			} else {
+				updates = append(updates, fmt.Sprintf("%s = excluded.%s", column, column))
+			}
+			columns = append(columns, column)
+			indexes = append(indexes, i)
+		}
+	}
+	if key < 0 {
+		return errors.Errorf("html table %s has no %s column", source.HTMLID, source.Key)
+	}
+	query := tx.Rebind(fmt.Sprintf(
+		"INSERT INTO %s%s (%s) VALUES (%s) ON CONFLICT (teamid, createddate, %s) DO UPDATE SET %s",
+		in.schema, source.Table, strings.Join(columns, ", "), strings.TrimSuffix(strings.Repeat("?, ", len(columns)), ", "),
+		source.Key, strings.Join(updates, ", "),
+	))
+	stmt, err := tx.PreparexContext(ctx, query)
+	if err != nil {
+		return errors.Wrapf(err, "error preparing %s upsert", source.Table)
+	}
+	defer stmt.Close()
+	args := make([]interface{}, len(columns))
+	args[0], args[1], args[2] = run.ID, run.Teamid, run.Createddate
+	for _, row := range t.Rows {
+		if row[key] == nil {
+			return errors.Errorf("%s row without a %s", source.Table, source.Key)
+		}
+		for j, i := range indexes {
+			args[j+3] = row[i]
+		}
+		if _, err := stmt.ExecContext(ctx, args...); err != nil {
+			return errors.Wrapf(err, "error upserting into %s", source.Table)
+		}
+	}
+	return nil
+}
+
+// completeRun marks a run complete, making its snapshot visible, once every table of every page has landed; a retry
+// of a complete run stamps it again, so the API's cache validators change with the rows it rewrote
+func (in *Ingester) completeRun(ctx context.Context, tx *sqlx.Tx, run Run) error {
+	var landed, tables int
+	for _, page := range Pages {
+		tables += len(page.Sources)
+	}
+	query := tx.Rebind(fmt.Sprintf("SELECT COUNT(*) FROM %sload_run_table WHERE runid = ?", in.schema))
+	if err := tx.GetContext(ctx, &landed, query, run.ID); err != nil {
+		return errors.Wrap(err, "error counting landed tables")
+	}
+	if landed < tables {
+		return nil
+	}
+	query = tx.Rebind(fmt.Sprintf(
+		"UPDATE %sload_run SET completedat = ? WHERE id = ?", in.schema,
+	))
+	_, err := tx.ExecContext(ctx, query, time.Now().UTC(), run.ID)
+	return errors.Wrap(err, "error completing run")
+}
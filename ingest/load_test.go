@@ -0,0 +1,271 @@
+package ingest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"sports-data-api/app"
+	"sports-data-api/db"
+
+	"github.com/go-chi/chi"
+	"github.com/jmoiron/sqlx"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// loadFixture is a migrated SQLite database with an API user, served by the REST API behind a response cache
+type loadFixture struct {
+	t     *testing.T
+	in    *Ingester
+	url   string
+	token string
+	nyy   int
+}
+
+func newLoadFixture(t *testing.T) *loadFixture {
+	t.Helper()
+	dbc := &db.Container{Conf: db.Dbconfig{Rdbms: "sqlite", Database: filepath.Join(t.TempDir(), "sda.db")}}
+	if err := dbc.Open(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { dbc.Close() })
+	if _, err := dbc.MigrateUp(); err != nil {
+		t.Fatal(err)
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte("developer"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dbc.Db.Exec("INSERT INTO users (email, pass, role) VALUES ('developer', ?, 'api')", string(hash)); err != nil {
+		t.Fatal(err)
+	}
+	repo, err := app.NewSQLRepository(dbc.Db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler, err := (&app.Server{Repo: repo, Router: chi.NewRouter(), Cache: app.NewCache(16, time.Hour)}).Handler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	resp, err := http.Post(srv.URL+"/account/generateToken", "application/json", strings.NewReader(`{"username":"developer","password":"developer"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var token app.JwtToken
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil || token.Token == "" {
+		t.Fatalf("generateToken: %d %v", resp.StatusCode, err)
+	}
+	f := &loadFixture{t: t, in: NewIngester(dbc.Db), url: srv.URL, token: token.Token}
+	if f.nyy, err = f.in.TeamID(context.Background(), "NYY"); err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+// pages reads the saved pages in testdata, keyed by page name, applying edit to each
+func (f *loadFixture) pages(names []string, edit func(name, page string) string) map[string]io.Reader {
+	f.t.Helper()
+	pages := map[string]io.Reader{}
+	for _, name := range names {
+		b, err := os.ReadFile(filepath.Join("testdata", name+".html"))
+		if err != nil {
+			f.t.Fatal(err)
+		}
+		page := string(b)
+		if edit != nil {
+			page = edit(name, page)
+		}
+		pages[name] = strings.NewReader(page)
+	}
+	return pages
+}
+
+// get requests the NYY batting snapshot, only if it no longer matches etag when one is given
+func (f *loadFixture) get(etag string) *http.Response {
+	f.t.Helper()
+	req, err := http.NewRequest(http.MethodGet, f.url+"/api/v1/mlb/batting/NYY", nil)
+	if err != nil {
+		f.t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer "+f.token)
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		f.t.Fatal(err)
+	}
+	f.t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+// batting returns the createddate of the NYY batting snapshot /batting serves and the names in it
+func (f *loadFixture) batting() (time.Time, []string) {
+	f.t.Helper()
+	resp := f.get("")
+	var rows []app.Batter
+	if err := json.NewDecoder(resp.Body).Decode(&rows); err != nil || resp.StatusCode != http.StatusOK {
+		f.t.Fatalf("/batting/NYY: %d %v", resp.StatusCode, err)
+	}
+	var (
+		createddate time.Time
+		names       []string
+	)
+	for _, row := range rows {
+		if !createddate.IsZero() && !row.Createddate.Time.Equal(createddate) {
+			f.t.Errorf("/batting/NYY mixes the %s and %s snapshots", createddate, row.Createddate.Time)
+		}
+		createddate = row.Createddate.Time
+		names = append(names, row.Name.String)
+	}
+	sort.Strings(names)
+	return createddate, names
+}
+
+// pageNames are the pages of a complete snapshot
+func pageNames() []string {
+	var names []string
+	for name := range Pages {
+		names = append(names, name)
+	}
+	return names
+}
+
+func TestLoadSnapshot(t *testing.T) {
+	ctx := context.Background()
+	f := newLoadFixture(t)
+	first, err := NewRun(f.nyy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.in.LoadSnapshot(ctx, first, f.pages(pageNames(), nil)); err != nil {
+		t.Fatal(err)
+	}
+	createddate, names := f.batting()
+	if !createddate.Equal(first.Createddate) || strings.Join(names, ", ") != "Aaron Judge, Gary Sanchez, Mike Tauchman*" {
+		t.Errorf("after the first run /batting serves %s %v, want the %s snapshot", createddate, names, first.Createddate)
+	}
+
+	// a run failing part way, on the pitching page after the batting tables were written, writes nothing
+	failed := Run{ID: "failed", Teamid: f.nyy, Createddate: first.Createddate.Add(time.Hour)}
+	err = f.in.LoadSnapshot(ctx, failed, f.pages(pageNames(), func(name, page string) string {
+		if name != "pitching" {
+			return page
+		}
+		return strings.Replace(page, `<a href="/players/c/colege01.shtml">Gerrit Cole</a>`, "", 1)
+	}))
+	if err == nil || !strings.Contains(err.Error(), "pitching row without a name") {
+		t.Fatalf("LoadSnapshot: %v, want the pitching row without a name", err)
+	}
+	if createddate, _ := f.batting(); !createddate.Equal(first.Createddate) {
+		t.Errorf("after the failed run /batting serves %s, want the %s snapshot", createddate, first.Createddate)
+	}
+	var audited int
+	if err := f.in.Db.Get(&audited, "SELECT COUNT(*) FROM audit WHERE runid = 'failed' AND statusid = ?", statusInsertError); err != nil || audited != 1 {
+		t.Errorf("failed run audited %d insert errors (%v), want 1", audited, err)
+	}
+
+	// a run that has only landed its batting page is hidden until the other pages land under it
+	second := Run{ID: "second", Teamid: f.nyy, Createddate: first.Createddate.Add(2 * time.Hour)}
+	if err := f.in.LoadSnapshot(ctx, second, f.pages([]string{"batting"}, func(name, page string) string {
+		return strings.Replace(page, "Mike Tauchman", "Clint Frazier", 1)
+	})); err != nil {
+		t.Fatal(err)
+	}
+	if createddate, _ := f.batting(); !createddate.Equal(first.Createddate) {
+		t.Errorf("with the second run unfinished /batting serves %s, want the %s snapshot", createddate, first.Createddate)
+	}
+	if err := f.in.LoadSnapshot(ctx, second, f.pages([]string{"pitching", "batting_splits", "pitching_splits"}, nil)); err != nil {
+		t.Fatal(err)
+	}
+	createddate, names = f.batting()
+	if !createddate.Equal(second.Createddate) || strings.Join(names, ", ") != "Aaron Judge, Clint Frazier*, Gary Sanchez" {
+		t.Errorf("after the second run /batting serves %s %v, want the %s snapshot", createddate, names, second.Createddate)
+	}
+
+	// retrying a finished run replaces its rows rather than adding to them
+	if err := f.in.LoadSnapshot(ctx, second, f.pages([]string{"batting"}, nil)); err != nil {
+		t.Fatal(err)
+	}
+	if _, names := f.batting(); len(names) != 4 {
+		t.Errorf("after retrying the batting page /batting serves %v, want Tauchman added to the three replaced rows", names)
+	}
+}
+
+func TestRetriedRunChangesValidators(t *testing.T) {
+	ctx := context.Background()
+	f := newLoadFixture(t)
+	run, err := NewRun(f.nyy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.in.LoadSnapshot(ctx, run, f.pages(pageNames(), nil)); err != nil {
+		t.Fatal(err)
+	}
+	f.batting()
+	resp := f.get("")
+	etag, modified := resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")
+	if resp.Header.Get("X-Cache") != "HIT" || etag == "" {
+		t.Fatalf("repeated /batting/NYY: X-Cache %q ETag %q, want a cached response with an entity tag", resp.Header.Get("X-Cache"), etag)
+	}
+	if resp := f.get(etag); resp.StatusCode != http.StatusNotModified {
+		t.Errorf("/batting/NYY matching its ETag: %d, want 304", resp.StatusCode)
+	}
+
+	// a retry rewrites the snapshot's rows at the same createddate
+	time.Sleep(time.Second) // Last-Modified has whole seconds
+	if err := f.in.LoadSnapshot(ctx, run, f.pages([]string{"batting"}, func(name, page string) string {
+		return strings.Replace(page, "Mike Tauchman", "Clint Frazier", 1)
+	})); err != nil {
+		t.Fatal(err)
+	}
+	resp = f.get(etag)
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("X-Cache") != "MISS" {
+		t.Fatalf("/batting/NYY after the retry: %d X-Cache %q, want the rewritten rows", resp.StatusCode, resp.Header.Get("X-Cache"))
+	}
+	if resp.Header.Get("ETag") == etag || resp.Header.Get("Last-Modified") == modified {
+		t.Errorf("after the retry ETag %s and Last-Modified %s are unchanged", etag, modified)
+	}
+	createddate, names := f.batting()
+	if !createddate.Equal(run.Createddate) || !strings.Contains(strings.Join(names, ", "), "Clint Frazier*") {
+		t.Errorf("after the retry /batting serves %s %v, want Clint Frazier in the %s snapshot", createddate, names, run.Createddate)
+	}
+}
+
+func TestIngestPageRefusesDuplicates(t *testing.T) {
+	ctx := context.Background()
+	f := newLoadFixture(t)
+	createddate := time.Date(2020, 8, 1, 6, 0, 0, 0, time.UTC)
+	if err := f.in.IngestPage(ctx, Pages["batting"], f.nyy, f.pages([]string{"batting"}, nil)["batting"], createddate); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.in.IngestPage(ctx, Pages["batting"], f.nyy, f.pages([]string{"batting"}, nil)["batting"], createddate); err == nil {
+		t.Error("IngestPage of a snapshot already ingested: want the unique snapshot index to refuse it")
+	}
+	if _, names := f.batting(); len(names) != 3 {
+		t.Errorf("/batting serves %v, want the three players once", names)
+	}
+}
+
+func TestLoadRunDrivers(t *testing.T) {
+	for _, driver := range []string{"mysql", "sqlserver"} {
+		in := NewIngester(sqlx.NewDb(nil, driver))
+		if err := in.LoadSnapshot(context.Background(), Run{}, nil); err == nil || !strings.Contains(err.Error(), "not supported on "+driver) {
+			t.Errorf("LoadSnapshot on %s: %v, want it rejected", driver, err)
+		}
+		if _, err := in.Run(context.Background(), "run"); err == nil {
+			t.Errorf("Run on %s: want it rejected", driver)
+		}
+	}
+}
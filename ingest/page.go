@@ -0,0 +1,278 @@
+package ingest
+
+import (
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Source pairs a <table> on a Baseball-Reference page with the baseballreference table its rows are loaded into
+type Source struct {
+	Table  string
+	HTMLID string
+	Key    string // column identifying a row within a team's snapshot of the table
+}
+
+// Page is a Baseball-Reference team page and the tables read from it
+type Page struct {
+	Name    string
+	URL     string // format string over the team abbreviation and season
+	Sources []Source
+}
+
+// Pages are the team pages the scraper reads, keyed by name; the split pages share table ids, so the page a saved
+// file came from decides the table its rows are loaded into
+var Pages = map[string]Page{
+	"batting": {
+		Name: "batting",
+		URL:  "https://www.baseball-reference.com/teams/%s/%d-batting.shtml",
+		Sources: []Source{
+			{Table: "batting", HTMLID: "team_batting", Key: "name"},
+			{Table: "baserunning", HTMLID: "players_baserunning_batting", Key: "name"},
+		},
+	},
+	"pitching": {
+		Name: "pitching",
+		URL:  "https://www.baseball-reference.com/teams/%s/%d-pitching.shtml",
+		Sources: []Source{
+			{Table: "pitching", HTMLID: "team_pitching", Key: "name"},
+			{Table: "batting_pitching", HTMLID: "players_batting_pitching", Key: "name"},
+		},
+	},
+	"batting_splits": {
+		Name: "batting_splits",
+		URL:  "https://www.baseball-reference.com/teams/split.cgi?t=b&team=%s&year=%d",
+		Sources: []Source{
+			{Table: "batting_splits", HTMLID: "plato", Key: "split"},
+			{Table: "batting_home_away", HTMLID: "hmvis", Key: "split"},
+		},
+	},
+	"pitching_splits": {
+		Name: "pitching_splits",
+		URL:  "https://www.baseball-reference.com/teams/split.cgi?t=p&team=%s&year=%d",
+		Sources: []Source{
+			{Table: "pitching_splits", HTMLID: "plato", Key: "split"},
+			{Table: "pitching_home_away", HTMLID: "hmvis", Key: "split"},
+		},
+	},
+}
+
+// columnRenames maps lowercased Baseball-Reference headers that are not valid column names to the baseballreference
+// columns, as the scraper's html_to_dataframe does
+var columnRenames = map[string]string{
+	"w-l%":  "wl",
+	"era+":  "eraplus",
+	"so/w":  "sow",
+	"ops+":  "opsplus",
+	"tops+": "topsplus",
+	"sops+": "sopsplus",
+	"xbt%":  "xbtpct",
+	"rs%":   "rspct",
+	"sb%":   "sbpct",
+	"2b":    "twob",
+	"3b":    "threeb",
+	"1sts":  "firsts",
+	"1sts2": "firsts2",
+	"1sts3": "firsts3",
+	"1std":  "firstd",
+	"1std3": "firstd3",
+	"1stdh": "firstdh",
+	"2nds":  "seconds",
+	"2nds3": "seconds3",
+	"2ndsh": "secondsh",
+}
+
+// excludedNames are the summary rows mixed in with the players, matched case-insensitively within the name
+var excludedNames = []string{"total", "rank in 15", "average"}
+
+// Table is a stat table parsed from a page: its columns under their baseballreference names and its player rows,
+// with integers and decimals parsed and blank cells nil
+type Table struct {
+	ID      string
+	Columns []string
+	Rows    [][]interface{}
+}
+
+// ParseTables reads every table with an id from a saved page, including the tables Baseball-Reference ships inside
+// HTML comments and only renders with javascript
+func ParseTables(r io.Reader) (map[string]*Table, error) {
+	doc, err := html.Parse(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing page")
+	}
+	tables := map[string]*Table{}
+	if err := findTables(doc, tables); err != nil {
+		return nil, err
+	}
+	return tables, nil
+}
+
+// findTables parses the tables under n, descending into comments
+func findTables(n *html.Node, tables map[string]*Table) error {
+	switch {
+	case n.Type == html.CommentNode && strings.Contains(n.Data, "<table"):
+		nodes, err := html.ParseFragment(strings.NewReader(n.Data), &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body})
+		if err != nil {
+			return errors.Wrap(err, "error parsing commented table")
+		}
+		for _, node := range nodes {
+			if err := findTables(node, tables); err != nil {
+				return err
+			}
+		}
+		return nil
+	case n.Type == html.ElementNode && n.DataAtom == atom.Table:
+		if id := attr(n, "id"); id != "" {
+			tables[id] = parseTable(id, n)
+		}
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if err := findTables(c, tables); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseTable reads the header from the last thead row and the player rows from tbody and tfoot, dropping repeated
+// header rows and the total, league rank and average rows
+func parseTable(id string, n *html.Node) *Table {
+	t := &Table{ID: id}
+	var header []string
+	for _, section := range children(n, atom.Thead) {
+		for _, tr := range children(section, atom.Tr) {
+			if hasClass(tr, "over_header") {
+				continue
+			}
+			header = cells(tr)
+		}
+	}
+	name := -1
+	for i, h := range header {
+		column := strings.ToLower(h)
+		if renamed, ok := columnRenames[column]; ok {
+			column = renamed
+		}
+		if column == "name" {
+			name = i
+		}
+		t.Columns = append(t.Columns, column)
+	}
+	for _, section := range children(n, atom.Tbody, atom.Tfoot) {
+		for _, tr := range children(section, atom.Tr) {
+			values := cells(tr)
+			if len(values) == 0 || hasClass(tr, "thead") || isHeader(values, header) || excluded(values, name) {
+				continue
+			}
+			row := make([]interface{}, len(t.Columns))
+			for i := range row {
+				if i < len(values) {
+					row[i] = value(values[i])
+				}
+			}
+			t.Rows = append(t.Rows, row)
+		}
+	}
+	return t
+}
+
+// isHeader reports whether a row repeats the header, as Baseball-Reference does every 20 or so players
+func isHeader(values, header []string) bool {
+	if len(values) != len(header) {
+		return false
+	}
+	for i := range values {
+		if values[i] != header[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// excluded reports whether the name cell of a row holds one of excludedNames
+func excluded(values []string, name int) bool {
+	if name < 0 || name >= len(values) {
+		return false
+	}
+	lower := strings.ToLower(values[name])
+	for _, s := range excludedNames {
+		if strings.Contains(lower, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// value parses a cell as an integer or decimal, keeping other text such as names and percentages as it is
+func value(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return i
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return f
+	}
+	return s
+}
+
+// cells returns the trimmed text of the th and td cells of a row
+func cells(tr *html.Node) []string {
+	var values []string
+	for _, cell := range children(tr, atom.Th, atom.Td) {
+		values = append(values, strings.TrimSpace(text(cell)))
+	}
+	return values
+}
+
+// children returns the element children of n with one of the given tags
+func children(n *html.Node, tags ...atom.Atom) []*html.Node {
+	var nodes []*html.Node
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type != html.ElementNode {
+			continue
+		}
+		for _, tag := range tags {
+			if c.DataAtom == tag {
+				nodes = append(nodes, c)
+				break
+			}
+		}
+	}
+	return nodes
+}
+
+// text concatenates the text under n
+func text(n *html.Node) string {
+	if n.Type == html.TextNode {
+		return n.Data
+	}
+	var b strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		b.WriteString(text(c))
+	}
+	return b.String()
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+func hasClass(n *html.Node, class string) bool {
+	for _, c := range strings.Fields(attr(n, "class")) {
+		if c == class {
+			return true
+		}
+	}
+	return false
+}
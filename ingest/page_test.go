@@ -0,0 +1,132 @@
+package ingest
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// parseFile parses a trimmed saved page from testdata
+func parseFile(t *testing.T, name string) map[string]*Table {
+	t.Helper()
+	f, err := os.Open(filepath.Join("testdata", name+".html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	tables, err := ParseTables(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tables
+}
+
+func TestParseTables(t *testing.T) {
+	for name, page := range Pages {
+		tables := parseFile(t, name)
+		for _, source := range page.Sources {
+			if _, ok := tables[source.HTMLID]; !ok {
+				t.Errorf("%s: no %s table", name, source.HTMLID)
+			}
+		}
+	}
+}
+
+func TestParseBatting(t *testing.T) {
+	tables := parseFile(t, "batting")
+	batting := tables["team_batting"]
+	// the over_header row is skipped and 2B, 3B and OPS+ are renamed
+	wantColumns := []string{"rk", "pos", "name", "age", "g", "pa", "ab", "twob", "threeb", "hr", "ba", "opsplus"}
+	if !reflect.DeepEqual(batting.Columns, wantColumns) {
+		t.Errorf("columns %v, want %v", batting.Columns, wantColumns)
+	}
+	// both repeated header rows and the totals, rank and average rows are dropped; blank cells are nil
+	wantRows := [][]interface{}{
+		{int64(1), "C", "Gary Sanchez", int64(27), int64(49), int64(178), int64(156), int64(9), int64(0), int64(10), 0.147, int64(69)},
+		{int64(2), "RF", "Aaron Judge", int64(28), int64(28), int64(114), int64(101), int64(3), int64(0), int64(9), 0.257, int64(149)},
+		{int64(3), nil, "Mike Tauchman*", int64(29), int64(43), int64(127), int64(112), int64(5), int64(0), int64(0), 0.241, nil},
+	}
+	if !reflect.DeepEqual(batting.Rows, wantRows) {
+		t.Errorf("rows\n%v\nwant\n%v", batting.Rows, wantRows)
+	}
+
+	// the baserunning table is only in an HTML comment
+	baserunning := tables["players_baserunning_batting"]
+	wantColumns = []string{"name", "age", "pa", "sb", "cs", "sbpct", "xbtpct", "firsts", "seconds3"}
+	if !reflect.DeepEqual(baserunning.Columns, wantColumns) {
+		t.Errorf("columns %v, want %v", baserunning.Columns, wantColumns)
+	}
+	wantRows = [][]interface{}{
+		{"Aaron Judge", int64(28), int64(114), int64(0), int64(1), "0%", "33%", int64(4), int64(1)},
+		{"Gary Sanchez", int64(27), int64(178), int64(0), int64(0), nil, "25%", int64(3), int64(0)},
+	}
+	if !reflect.DeepEqual(baserunning.Rows, wantRows) {
+		t.Errorf("rows\n%v\nwant\n%v", baserunning.Rows, wantRows)
+	}
+}
+
+func TestParsePitching(t *testing.T) {
+	tables := parseFile(t, "pitching")
+	pitching := tables["team_pitching"]
+	wantColumns := []string{"rk", "pos", "name", "age", "w", "l", "wl", "era", "ip", "so", "eraplus", "sow"}
+	if !reflect.DeepEqual(pitching.Columns, wantColumns) {
+		t.Errorf("columns %v, want %v", pitching.Columns, wantColumns)
+	}
+	if len(pitching.Rows) != 2 || pitching.Rows[0][6] != 0.7 || pitching.Rows[0][8] != 73.0 {
+		t.Errorf("rows %v, want Cole and Tanaka with wl and ip as decimals", pitching.Rows)
+	}
+	if rows := tables["players_batting_pitching"].Rows; len(rows) != 2 {
+		t.Errorf("batting_pitching rows %v, want 2", rows)
+	}
+}
+
+func TestParseSplits(t *testing.T) {
+	tables := parseFile(t, "batting_splits")
+	plato := tables["plato"]
+	// splits have no name column, so no row is dropped as a summary
+	wantColumns := []string{"split", "g", "pa", "ab", "hr", "ba", "topsplus", "sopsplus"}
+	if !reflect.DeepEqual(plato.Columns, wantColumns) {
+		t.Errorf("columns %v, want %v", plato.Columns, wantColumns)
+	}
+	var splits []string
+	for _, table := range []*Table{plato, tables["hmvis"]} {
+		for _, row := range table.Rows {
+			splits = append(splits, row[0].(string))
+		}
+	}
+	if got, want := strings.Join(splits, ", "), "vs RH Starter, vs LH Starter, Home, Away"; got != want {
+		t.Errorf("splits %s, want %s", got, want)
+	}
+}
+
+func TestParseTablesWithoutID(t *testing.T) {
+	tables, err := ParseTables(strings.NewReader(`<table><tr><th>Name</th></tr><tr><td>Aaron Judge</td></tr></table>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tables) != 0 {
+		t.Errorf("tables %v, want none for a table without an id", tables)
+	}
+}
+
+func TestValue(t *testing.T) {
+	tests := []struct {
+		cell string
+		want interface{}
+	}{
+		{"", nil},
+		{"12", int64(12)},
+		{"-3", int64(-3)},
+		{".333", 0.333},
+		{"29.2", 29.2},
+		{"33%", "33%"},
+		{"Aaron Judge", "Aaron Judge"},
+	}
+	for _, tt := range tests {
+		if got := value(tt.cell); got != tt.want {
+			t.Errorf("value(%q) = %#v, want %#v", tt.cell, got, tt.want)
+		}
+	}
+}
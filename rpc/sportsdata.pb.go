@@ -0,0 +1,4920 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: rpc/sportsdata.proto
+
+// sportsdata mirrors the stat, snapshot, history, diff and leaderboard endpoints of the REST API under /api/v1/mlb; regenerate the Go code with
+// protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative rpc/sportsdata.proto
+
+package rpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Filter is a single comparison on a stat field; op is one of eq, ne, gt, gte, lt, lte, like or in (default eq)
+type Filter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Op            string                 `protobuf:"bytes,2,opt,name=op,proto3" json:"op,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Filter) Reset() {
+	*x = Filter{}
+	mi := &file_rpc_sportsdata_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Filter) ProtoMessage() {}
+
+func (x *Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sportsdata_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Filter.ProtoReflect.Descriptor instead.
+func (*Filter) Descriptor() ([]byte, []int) {
+	return file_rpc_sportsdata_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Filter) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Filter) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *Filter) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// StatRequest selects the rows of a stat table; it takes the same parameters as the REST endpoints
+type StatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teamabbrev    string                 `protobuf:"bytes,1,opt,name=teamabbrev,proto3" json:"teamabbrev,omitempty"` // empty for every team
+	Asof          string                 `protobuf:"bytes,2,opt,name=asof,proto3" json:"asof,omitempty"`             // latest snapshot at or before this date or RFC 3339 instant
+	Snapshot      string                 `protobuf:"bytes,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"`     // exact snapshot createddate
+	Filters       []*Filter              `protobuf:"bytes,4,rep,name=filters,proto3" json:"filters,omitempty"`
+	Sort          string                 `protobuf:"bytes,5,opt,name=sort,proto3" json:"sort,omitempty"` // field name, prefixed with - for descending
+	Limit         int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatRequest) Reset() {
+	*x = StatRequest{}
+	mi := &file_rpc_sportsdata_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatRequest) ProtoMessage() {}
+
+func (x *StatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sportsdata_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatRequest.ProtoReflect.Descriptor instead.
+func (*StatRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_sportsdata_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StatRequest) GetTeamabbrev() string {
+	if x != nil {
+		return x.Teamabbrev
+	}
+	return ""
+}
+
+func (x *StatRequest) GetAsof() string {
+	if x != nil {
+		return x.Asof
+	}
+	return ""
+}
+
+func (x *StatRequest) GetSnapshot() string {
+	if x != nil {
+		return x.Snapshot
+	}
+	return ""
+}
+
+func (x *StatRequest) GetFilters() []*Filter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *StatRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *StatRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListTeamsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamsRequest) Reset() {
+	*x = ListTeamsRequest{}
+	mi := &file_rpc_sportsdata_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamsRequest) ProtoMessage() {}
+
+func (x *ListTeamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sportsdata_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamsRequest.ProtoReflect.Descriptor instead.
+func (*ListTeamsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_sportsdata_proto_rawDescGZIP(), []int{2}
+}
+
+type ListTeamsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teams         []*Team                `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamsResponse) Reset() {
+	*x = ListTeamsResponse{}
+	mi := &file_rpc_sportsdata_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamsResponse) ProtoMessage() {}
+
+func (x *ListTeamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sportsdata_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamsResponse.ProtoReflect.Descriptor instead.
+func (*ListTeamsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_sportsdata_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListTeamsResponse) GetTeams() []*Team {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+type ListBattingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Batters       []*Batter              `protobuf:"bytes,1,rep,name=batters,proto3" json:"batters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBattingResponse) Reset() {
+	*x = ListBattingResponse{}
+	mi := &file_rpc_sportsdata_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBattingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBattingResponse) ProtoMessage() {}
+
+func (x *ListBattingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sportsdata_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBattingResponse.ProtoReflect.Descriptor instead.
+func (*ListBattingResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_sportsdata_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListBattingResponse) GetBatters() []*Batter {
+	if x != nil {
+		return x.Batters
+	}
+	return nil
+}
+
+type ListPitchingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pitchers      []*Pitcher             `protobuf:"bytes,1,rep,name=pitchers,proto3" json:"pitchers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPitchingResponse) Reset() {
+	*x = ListPitchingResponse{}
+	mi := &file_rpc_sportsdata_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPitchingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPitchingResponse) ProtoMessage() {}
+
+func (x *ListPitchingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sportsdata_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPitchingResponse.ProtoReflect.Descriptor instead.
+func (*ListPitchingResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_sportsdata_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListPitchingResponse) GetPitchers() []*Pitcher {
+	if x != nil {
+		return x.Pitchers
+	}
+	return nil
+}
+
+type ListBattingSplitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Splits        []*BattingSplit        `protobuf:"bytes,1,rep,name=splits,proto3" json:"splits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBattingSplitsResponse) Reset() {
+	*x = ListBattingSplitsResponse{}
+	mi := &file_rpc_sportsdata_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBattingSplitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBattingSplitsResponse) ProtoMessage() {}
+
+func (x *ListBattingSplitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sportsdata_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBattingSplitsResponse.ProtoReflect.Descriptor instead.
+func (*ListBattingSplitsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_sportsdata_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListBattingSplitsResponse) GetSplits() []*BattingSplit {
+	if x != nil {
+		return x.Splits
+	}
+	return nil
+}
+
+type ListPitchingSplitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Splits        []*PitchingSplit       `protobuf:"bytes,1,rep,name=splits,proto3" json:"splits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPitchingSplitsResponse) Reset() {
+	*x = ListPitchingSplitsResponse{}
+	mi := &file_rpc_sportsdata_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPitchingSplitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPitchingSplitsResponse) ProtoMessage() {}
+
+func (x *ListPitchingSplitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sportsdata_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPitchingSplitsResponse.ProtoReflect.Descriptor instead.
+func (*ListPitchingSplitsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_sportsdata_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListPitchingSplitsResponse) GetSplits() []*PitchingSplit {
+	if x != nil {
+		return x.Splits
+	}
+	return nil
+}
+
+type ListBaserunningResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Baserunners   []*Baserunner          `protobuf:"bytes,1,rep,name=baserunners,proto3" json:"baserunners,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBaserunningResponse) Reset() {
+	*x = ListBaserunningResponse{}
+	mi := &file_rpc_sportsdata_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBaserunningResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBaserunningResponse) ProtoMessage() {}
+
+func (x *ListBaserunningResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sportsdata_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBaserunningResponse.ProtoReflect.Descriptor instead.
+func (*ListBaserunningResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_sportsdata_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListBaserunningResponse) GetBaserunners() []*Baserunner {
+	if x != nil {
+		return x.Baserunners
+	}
+	return nil
+}
+
+type ListBattingPitchingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rows          []*BattingPitching     `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBattingPitchingResponse) Reset() {
+	*x = ListBattingPitchingResponse{}
+	mi := &file_rpc_sportsdata_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBattingPitchingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBattingPitchingResponse) ProtoMessage() {}
+
+func (x *ListBattingPitchingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sportsdata_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBattingPitchingResponse.ProtoReflect.Descriptor instead.
+func (*ListBattingPitchingResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_sportsdata_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListBattingPitchingResponse) GetRows() []*BattingPitching {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+// ListSnapshotsRequest takes the same parameters as /snapshots/{table}/{teamabbrev}
+type ListSnapshotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Table         string                 `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`           // empty for every snapshot table
+	Teamabbrev    string                 `protobuf:"bytes,2,opt,name=teamabbrev,proto3" json:"teamabbrev,omitempty"` // empty for every team
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSnapshotsRequest) Reset() {
+	*x = ListSnapshotsRequest{}
+	mi := &file_rpc_sportsdata_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSnapshotsRequest) ProtoMessage() {}
+
+func (x *ListSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sportsdata_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*ListSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_sportsdata_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListSnapshotsRequest) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *ListSnapshotsRequest) GetTeamabbrev() string {
+	if x != nil {
+		return x.Teamabbrev
+	}
+	return ""
+}
+
+type ListSnapshotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshots     []*Snapshot            `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSnapshotsResponse) Reset() {
+	*x = ListSnapshotsResponse{}
+	mi := &file_rpc_sportsdata_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSnapshotsResponse) ProtoMessage() {}
+
+func (x *ListSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sportsdata_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*ListSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_sportsdata_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListSnapshotsResponse) GetSnapshots() []*Snapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+// PlayerHistoryRequest takes the same parameters as /players/{name}/history
+type PlayerHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Stat          string                 `protobuf:"bytes,2,opt,name=stat,proto3" json:"stat,omitempty"` // batting, pitching, baserunning or batting_pitching; default batting
+	From          string                 `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"` // inclusive createddate bounds
+	To            string                 `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Filters       []*Filter              `protobuf:"bytes,5,rep,name=filters,proto3" json:"filters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerHistoryRequest) Reset() {
+	*x = PlayerHistoryRequest{}
+	mi := &file_rpc_sportsdata_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerHistoryRequest) ProtoMessage() {}
+
+func (x *PlayerHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sportsdata_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerHistoryRequest.ProtoReflect.Descriptor instead.
+func (*PlayerHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_sportsdata_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PlayerHistoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerHistoryRequest) GetStat() string {
+	if x != nil {
+		return x.Stat
+	}
+	return ""
+}
+
+func (x *PlayerHistoryRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *PlayerHistoryRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *PlayerHistoryRequest) GetFilters() []*Filter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+// PlayerHistoryResponse holds the rows of the requested stat; the other fields are empty
+type PlayerHistoryResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Batters         []*Batter              `protobuf:"bytes,1,rep,name=batters,proto3" json:"batters,omitempty"`
+	Pitchers        []*Pitcher             `protobuf:"bytes,2,rep,name=pitchers,proto3" json:"pitchers,omitempty"`
+	Baserunners     []*Baserunner          `protobuf:"bytes,3,rep,name=baserunners,proto3" json:"baserunners,omitempty"`
+	BattingPitching []*BattingPitching     `protobuf:"bytes,4,rep,name=batting_pitching,json=battingPitching,proto3" json:"batting_pitching,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PlayerHistoryResponse) Reset() {
+	*x = PlayerHistoryResponse{}
+	mi := &file_rpc_sportsdata_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerHistoryResponse) ProtoMessage() {}
+
+func (x *PlayerHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sportsdata_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerHistoryResponse.ProtoReflect.Descriptor instead.
+func (*PlayerHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_sportsdata_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PlayerHistoryResponse) GetBatters() []*Batter {
+	if x != nil {
+		return x.Batters
+	}
+	return nil
+}
+
+func (x *PlayerHistoryResponse) GetPitchers() []*Pitcher {
+	if x != nil {
+		return x.Pitchers
+	}
+	return nil
+}
+
+func (x *PlayerHistoryResponse) GetBaserunners() []*Baserunner {
+	if x != nil {
+		return x.Baserunners
+	}
+	return nil
+}
+
+func (x *PlayerHistoryResponse) GetBattingPitching() []*BattingPitching {
+	if x != nil {
+		return x.BattingPitching
+	}
+	return nil
+}
+
+// DiffRequest takes the same parameters as /{table}/{teamabbrev}/diff; without from the delta is the season to date
+type DiffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teamabbrev    string                 `protobuf:"bytes,1,opt,name=teamabbrev,proto3" json:"teamabbrev,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"` // the snapshot current at from is the baseline
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`     // the snapshot current at to is the end; latest when empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffRequest) Reset() {
+	*x = DiffRequest{}
+	mi := &file_rpc_sportsdata_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffRequest) ProtoMessage() {}
+
+func (x *DiffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sportsdata_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffRequest.ProtoReflect.Descriptor instead.
+func (*DiffRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_sportsdata_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DiffRequest) GetTeamabbrev() string {
+	if x != nil {
+		return x.Teamabbrev
+	}
+	return ""
+}
+
+func (x *DiffRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *DiffRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type DiffBattingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deltas        []*BattingDelta        `protobuf:"bytes,1,rep,name=deltas,proto3" json:"deltas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffBattingResponse) Reset() {
+	*x = DiffBattingResponse{}
+	mi := &file_rpc_sportsdata_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffBattingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffBattingResponse) ProtoMessage() {}
+
+func (x *DiffBattingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sportsdata_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffBattingResponse.ProtoReflect.Descriptor instead.
+func (*DiffBattingResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_sportsdata_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DiffBattingResponse) GetDeltas() []*BattingDelta {
+	if x != nil {
+		return x.Deltas
+	}
+	return nil
+}
+
+type DiffPitchingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deltas        []*PitchingDelta       `protobuf:"bytes,1,rep,name=deltas,proto3" json:"deltas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffPitchingResponse) Reset() {
+	*x = DiffPitchingResponse{}
+	mi := &file_rpc_sportsdata_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffPitchingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffPitchingResponse) ProtoMessage() {}
+
+func (x *DiffPitchingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sportsdata_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffPitchingResponse.ProtoReflect.Descriptor instead.
+func (*DiffPitchingResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_sportsdata_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DiffPitchingResponse) GetDeltas() []*PitchingDelta {
+	if x != nil {
+		return x.Deltas
+	}
+	return nil
+}
+
+type DiffBaserunningResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deltas        []*BaserunnerDelta     `protobuf:"bytes,1,rep,name=deltas,proto3" json:"deltas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffBaserunningResponse) Reset() {
+	*x = DiffBaserunningResponse{}
+	mi := &file_rpc_sportsdata_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffBaserunningResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffBaserunningResponse) ProtoMessage() {}
+
+func (x *DiffBaserunningResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sportsdata_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffBaserunningResponse.ProtoReflect.Descriptor instead.
+func (*DiffBaserunningResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_sportsdata_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DiffBaserunningResponse) GetDeltas() []*BaserunnerDelta {
+	if x != nil {
+		return x.Deltas
+	}
+	return nil
+}
+
+// LeadersRequest takes the same parameters as /leaders/{table}; limit bounds the rank, so ties at the last rank are
+// all returned
+type LeadersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Asof          string                 `protobuf:"bytes,1,opt,name=asof,proto3" json:"asof,omitempty"`
+	Snapshot      string                 `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	Filters       []*Filter              `protobuf:"bytes,3,rep,name=filters,proto3" json:"filters,omitempty"`
+	Sort          string                 `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`    // field name, prefixed with - for descending; default -ops for batting and era for pitching
+	Min           float64                `protobuf:"fixed64,5,opt,name=min,proto3" json:"min,omitempty"`    // qualification threshold: min_pa for batting, min_ip for pitching
+	Limit         int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"` // default 25
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeadersRequest) Reset() {
+	*x = LeadersRequest{}
+	mi := &file_rpc_sportsdata_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeadersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeadersRequest) ProtoMessage() {}
+
+func (x *LeadersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sportsdata_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeadersRequest.ProtoReflect.Descriptor instead.
+func (*LeadersRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_sportsdata_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *LeadersRequest) GetAsof() string {
+	if x != nil {
+		return x.Asof
+	}
+	return ""
+}
+
+func (x *LeadersRequest) GetSnapshot() string {
+	if x != nil {
+		return x.Snapshot
+	}
+	return ""
+}
+
+func (x *LeadersRequest) GetFilters() []*Filter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *LeadersRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *LeadersRequest) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *LeadersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type BattingLeadersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Leaders       []*BattingLeader       `protobuf:"bytes,1,rep,name=leaders,proto3" json:"leaders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BattingLeadersResponse) Reset() {
+	*x = BattingLeadersResponse{}
+	mi := &file_rpc_sportsdata_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BattingLeadersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BattingLeadersResponse) ProtoMessage() {}
+
+func (x *BattingLeadersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sportsdata_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BattingLeadersResponse.ProtoReflect.Descriptor instead.
+func (*BattingLeadersResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_sportsdata_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BattingLeadersResponse) GetLeaders() []*BattingLeader {
+	if x != nil {
+		return x.Leaders
+	}
+	return nil
+}
+
+type PitchingLeadersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Leaders       []*PitchingLeader      `protobuf:"bytes,1,rep,name=leaders,proto3" json:"leaders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PitchingLeadersResponse) Reset() {
+	*x = PitchingLeadersResponse{}
+	mi := &file_rpc_sportsdata_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PitchingLeadersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PitchingLeadersResponse) ProtoMessage() {}
+
+func (x *PitchingLeadersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sportsdata_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PitchingLeadersResponse.ProtoReflect.Descriptor instead.
+func (*PitchingLeadersResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_sportsdata_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PitchingLeadersResponse) GetLeaders() []*PitchingLeader {
+	if x != nil {
+		return x.Leaders
+	}
+	return nil
+}
+
+// Team represents a single MLB team
+type Team struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Teamname      string                 `protobuf:"bytes,2,opt,name=teamname,proto3" json:"teamname,omitempty"`
+	Teamabbrev    string                 `protobuf:"bytes,3,opt,name=teamabbrev,proto3" json:"teamabbrev,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	mi := &file_rpc_sportsdata_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sportsdata_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_rpc_sportsdata_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Team) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Team) GetTeamname() string {
+	if x != nil {
+		return x.Teamname
+	}
+	return ""
+}
+
+func (x *Team) GetTeamabbrev() string {
+	if x != nil {
+		return x.Teamabbrev
+	}
+	return ""
+}
+
+// Batter represents data for a single batter
+type Batter struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            int64                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Teamabbrev    string                  `protobuf:"bytes,2,opt,name=teamabbrev,proto3" json:"teamabbrev,omitempty"`
+	Rk            int64                   `protobuf:"varint,3,opt,name=rk,proto3" json:"rk,omitempty"`
+	Pos           *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=pos,proto3" json:"pos,omitempty"`
+	Name          *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Age           *wrapperspb.Int64Value  `protobuf:"bytes,6,opt,name=age,proto3" json:"age,omitempty"`
+	G             *wrapperspb.Int64Value  `protobuf:"bytes,7,opt,name=g,proto3" json:"g,omitempty"`
+	Pa            *wrapperspb.Int64Value  `protobuf:"bytes,8,opt,name=pa,proto3" json:"pa,omitempty"`
+	Ab            *wrapperspb.Int64Value  `protobuf:"bytes,9,opt,name=ab,proto3" json:"ab,omitempty"`
+	R             *wrapperspb.Int64Value  `protobuf:"bytes,10,opt,name=r,proto3" json:"r,omitempty"`
+	H             *wrapperspb.Int64Value  `protobuf:"bytes,11,opt,name=h,proto3" json:"h,omitempty"`
+	Twob          *wrapperspb.Int64Value  `protobuf:"bytes,12,opt,name=twob,proto3" json:"twob,omitempty"`
+	Threeb        *wrapperspb.Int64Value  `protobuf:"bytes,13,opt,name=threeb,proto3" json:"threeb,omitempty"`
+	Hr            *wrapperspb.Int64Value  `protobuf:"bytes,14,opt,name=hr,proto3" json:"hr,omitempty"`
+	Rbi           *wrapperspb.Int64Value  `protobuf:"bytes,15,opt,name=rbi,proto3" json:"rbi,omitempty"`
+	Sb            *wrapperspb.Int64Value  `protobuf:"bytes,16,opt,name=sb,proto3" json:"sb,omitempty"`
+	Cs            *wrapperspb.Int64Value  `protobuf:"bytes,17,opt,name=cs,proto3" json:"cs,omitempty"`
+	Bb            *wrapperspb.Int64Value  `protobuf:"bytes,18,opt,name=bb,proto3" json:"bb,omitempty"`
+	So            *wrapperspb.Int64Value  `protobuf:"bytes,19,opt,name=so,proto3" json:"so,omitempty"`
+	Ba            *wrapperspb.DoubleValue `protobuf:"bytes,20,opt,name=ba,proto3" json:"ba,omitempty"`
+	Obp           *wrapperspb.DoubleValue `protobuf:"bytes,21,opt,name=obp,proto3" json:"obp,omitempty"`
+	Slg           *wrapperspb.DoubleValue `protobuf:"bytes,22,opt,name=slg,proto3" json:"slg,omitempty"`
+	Ops           *wrapperspb.DoubleValue `protobuf:"bytes,23,opt,name=ops,proto3" json:"ops,omitempty"`
+	Opsplus       *wrapperspb.Int64Value  `protobuf:"bytes,24,opt,name=opsplus,proto3" json:"opsplus,omitempty"`
+	Tb            *wrapperspb.Int64Value  `protobuf:"bytes,25,opt,name=tb,proto3" json:"tb,omitempty"`
+	Gdp           *wrapperspb.Int64Value  `protobuf:"bytes,26,opt,name=gdp,proto3" json:"gdp,omitempty"`
+	Hbp           *wrapperspb.Int64Value  `protobuf:"bytes,27,opt,name=hbp,proto3" json:"hbp,omitempty"`
+	Sh            *wrapperspb.Int64Value  `protobuf:"bytes,28,opt,name=sh,proto3" json:"sh,omitempty"`
+	Sf            *wrapperspb.Int64Value  `protobuf:"bytes,29,opt,name=sf,proto3" json:"sf,omitempty"`
+	Ibb           *wrapperspb.Int64Value  `protobuf:"bytes,30,opt,name=ibb,proto3" json:"ibb,omitempty"`
+	Createddate   *timestamppb.Timestamp  `protobuf:"bytes,31,opt,name=createddate,proto3" json:"createddate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Batter) Reset() {
+	*x = Batter{}
+	mi := &file_rpc_sportsdata_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Batter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Batter) ProtoMessage() {}
+
+func (x *Batter) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sportsdata_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Batter.ProtoReflect.Descriptor instead.
+func (*Batter) Descriptor() ([]byte, []int) {
+	return file_rpc_sportsdata_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Batter) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Batter) GetTeamabbrev() string {
+	if x != nil {
+		return x.Teamabbrev
+	}
+	return ""
+}
+
+func (x *Batter) GetRk() int64 {
+	if x != nil {
+		return x.Rk
+	}
+	return 0
+}
+
+func (x *Batter) GetPos() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Pos
+	}
+	return nil
+}
+
+func (x *Batter) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *Batter) GetAge() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+func (x *Batter) GetG() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.G
+	}
+	return nil
+}
+
+func (x *Batter) GetPa() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Pa
+	}
+	return nil
+}
+
+func (x *Batter) GetAb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Ab
+	}
+	return nil
+}
+
+func (x *Batter) GetR() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.R
+	}
+	return nil
+}
+
+func (x *Batter) GetH() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.H
+	}
+	return nil
+}
+
+func (x *Batter) GetTwob() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Twob
+	}
+	return nil
+}
+
+func (x *Batter) GetThreeb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Threeb
+	}
+	return nil
+}
+
+func (x *Batter) GetHr() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Hr
+	}
+	return nil
+}
+
+func (x *Batter) GetRbi() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Rbi
+	}
+	return nil
+}
+
+func (x *Batter) GetSb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Sb
+	}
+	return nil
+}
+
+func (x *Batter) GetCs() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Cs
+	}
+	return nil
+}
+
+func (x *Batter) GetBb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Bb
+	}
+	return nil
+}
+
+func (x *Batter) GetSo() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.So
+	}
+	return nil
+}
+
+func (x *Batter) GetBa() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Ba
+	}
+	return nil
+}
+
+func (x *Batter) GetObp() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Obp
+	}
+	return nil
+}
+
+func (x *Batter) GetSlg() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Slg
+	}
+	return nil
+}
+
+func (x *Batter) GetOps() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Ops
+	}
+	return nil
+}
+
+func (x *Batter) GetOpsplus() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Opsplus
+	}
+	return nil
+}
+
+func (x *Batter) GetTb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Tb
+	}
+	return nil
+}
+
+func (x *Batter) GetGdp() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Gdp
+	}
+	return nil
+}
+
+func (x *Batter) GetHbp() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Hbp
+	}
+	return nil
+}
+
+func (x *Batter) GetSh() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Sh
+	}
+	return nil
+}
+
+func (x *Batter) GetSf() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Sf
+	}
+	return nil
+}
+
+func (x *Batter) GetIbb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Ibb
+	}
+	return nil
+}
+
+func (x *Batter) GetCreateddate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Createddate
+	}
+	return nil
+}
+
+// Pitcher represents data for a single pitcher
+type Pitcher struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            int64                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Teamabbrev    string                  `protobuf:"bytes,2,opt,name=teamabbrev,proto3" json:"teamabbrev,omitempty"`
+	Rk            int64                   `protobuf:"varint,3,opt,name=rk,proto3" json:"rk,omitempty"`
+	Pos           *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=pos,proto3" json:"pos,omitempty"`
+	Name          *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Age           *wrapperspb.Int64Value  `protobuf:"bytes,6,opt,name=age,proto3" json:"age,omitempty"`
+	W             *wrapperspb.Int64Value  `protobuf:"bytes,7,opt,name=w,proto3" json:"w,omitempty"`
+	L             *wrapperspb.Int64Value  `protobuf:"bytes,8,opt,name=l,proto3" json:"l,omitempty"`
+	Wl            *wrapperspb.DoubleValue `protobuf:"bytes,9,opt,name=wl,proto3" json:"wl,omitempty"`
+	Era           *wrapperspb.DoubleValue `protobuf:"bytes,10,opt,name=era,proto3" json:"era,omitempty"`
+	G             *wrapperspb.Int64Value  `protobuf:"bytes,11,opt,name=g,proto3" json:"g,omitempty"`
+	Gs            *wrapperspb.Int64Value  `protobuf:"bytes,12,opt,name=gs,proto3" json:"gs,omitempty"`
+	Gf            *wrapperspb.Int64Value  `protobuf:"bytes,13,opt,name=gf,proto3" json:"gf,omitempty"`
+	Cg            *wrapperspb.Int64Value  `protobuf:"bytes,14,opt,name=cg,proto3" json:"cg,omitempty"`
+	Sho           *wrapperspb.Int64Value  `protobuf:"bytes,15,opt,name=sho,proto3" json:"sho,omitempty"`
+	Sv            *wrapperspb.Int64Value  `protobuf:"bytes,16,opt,name=sv,proto3" json:"sv,omitempty"`
+	Ip            *wrapperspb.DoubleValue `protobuf:"bytes,17,opt,name=ip,proto3" json:"ip,omitempty"`
+	H             *wrapperspb.Int64Value  `protobuf:"bytes,18,opt,name=h,proto3" json:"h,omitempty"`
+	R             *wrapperspb.Int64Value  `protobuf:"bytes,19,opt,name=r,proto3" json:"r,omitempty"`
+	Er            *wrapperspb.Int64Value  `protobuf:"bytes,20,opt,name=er,proto3" json:"er,omitempty"`
+	Hr            *wrapperspb.Int64Value  `protobuf:"bytes,21,opt,name=hr,proto3" json:"hr,omitempty"`
+	Bb            *wrapperspb.Int64Value  `protobuf:"bytes,22,opt,name=bb,proto3" json:"bb,omitempty"`
+	Ibb           *wrapperspb.Int64Value  `protobuf:"bytes,23,opt,name=ibb,proto3" json:"ibb,omitempty"`
+	So            *wrapperspb.Int64Value  `protobuf:"bytes,24,opt,name=so,proto3" json:"so,omitempty"`
+	Hbp           *wrapperspb.Int64Value  `protobuf:"bytes,25,opt,name=hbp,proto3" json:"hbp,omitempty"`
+	Bk            *wrapperspb.Int64Value  `protobuf:"bytes,26,opt,name=bk,proto3" json:"bk,omitempty"`
+	Wp            *wrapperspb.Int64Value  `protobuf:"bytes,27,opt,name=wp,proto3" json:"wp,omitempty"`
+	Bf            *wrapperspb.Int64Value  `protobuf:"bytes,28,opt,name=bf,proto3" json:"bf,omitempty"`
+	Eraplus       *wrapperspb.Int64Value  `protobuf:"bytes,29,opt,name=eraplus,proto3" json:"eraplus,omitempty"`
+	Fip           *wrapperspb.DoubleValue `protobuf:"bytes,30,opt,name=fip,proto3" json:"fip,omitempty"`
+	Whip          *wrapperspb.DoubleValue `protobuf:"bytes,31,opt,name=whip,proto3" json:"whip,omitempty"`
+	H9            *wrapperspb.DoubleValue `protobuf:"bytes,32,opt,name=h9,proto3" json:"h9,omitempty"`
+	Hr9           *wrapperspb.DoubleValue `protobuf:"bytes,33,opt,name=hr9,proto3" json:"hr9,omitempty"`
+	Bb9           *wrapperspb.DoubleValue `protobuf:"bytes,34,opt,name=bb9,proto3" json:"bb9,omitempty"`
+	So9           *wrapperspb.DoubleValue `protobuf:"bytes,35,opt,name=so9,proto3" json:"so9,omitempty"`
+	Sow           *wrapperspb.DoubleValue `protobuf:"bytes,36,opt,name=sow,proto3" json:"sow,omitempty"`
+	Createddate   *timestamppb.Timestamp  `protobuf:"bytes,37,opt,name=createddate,proto3" json:"createddate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pitcher) Reset() {
+	*x = Pitcher{}
+	mi := &file_rpc_sportsdata_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pitcher) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pitcher) ProtoMessage() {}
+
+func (x *Pitcher) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sportsdata_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pitcher.ProtoReflect.Descriptor instead.
+func (*Pitcher) Descriptor() ([]byte, []int) {
+	return file_rpc_sportsdata_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Pitcher) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Pitcher) GetTeamabbrev() string {
+	if x != nil {
+		return x.Teamabbrev
+	}
+	return ""
+}
+
+func (x *Pitcher) GetRk() int64 {
+	if x != nil {
+		return x.Rk
+	}
+	return 0
+}
+
+func (x *Pitcher) GetPos() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Pos
+	}
+	return nil
+}
+
+func (x *Pitcher) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *Pitcher) GetAge() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+func (x *Pitcher) GetW() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.W
+	}
+	return nil
+}
+
+func (x *Pitcher) GetL() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.L
+	}
+	return nil
+}
+
+func (x *Pitcher) GetWl() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Wl
+	}
+	return nil
+}
+
+func (x *Pitcher) GetEra() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Era
+	}
+	return nil
+}
+
+func (x *Pitcher) GetG() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.G
+	}
+	return nil
+}
+
+func (x *Pitcher) GetGs() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Gs
+	}
+	return nil
+}
+
+func (x *Pitcher) GetGf() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Gf
+	}
+	return nil
+}
+
+func (x *Pitcher) GetCg() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Cg
+	}
+	return nil
+}
+
+func (x *Pitcher) GetSho() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Sho
+	}
+	return nil
+}
+
+func (x *Pitcher) GetSv() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Sv
+	}
+	return nil
+}
+
+func (x *Pitcher) GetIp() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Ip
+	}
+	return nil
+}
+
+func (x *Pitcher) GetH() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.H
+	}
+	return nil
+}
+
+func (x *Pitcher) GetR() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.R
+	}
+	return nil
+}
+
+func (x *Pitcher) GetEr() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Er
+	}
+	return nil
+}
+
+func (x *Pitcher) GetHr() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Hr
+	}
+	return nil
+}
+
+func (x *Pitcher) GetBb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Bb
+	}
+	return nil
+}
+
+func (x *Pitcher) GetIbb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Ibb
+	}
+	return nil
+}
+
+func (x *Pitcher) GetSo() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.So
+	}
+	return nil
+}
+
+func (x *Pitcher) GetHbp() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Hbp
+	}
+	return nil
+}
+
+func (x *Pitcher) GetBk() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Bk
+	}
+	return nil
+}
+
+func (x *Pitcher) GetWp() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Wp
+	}
+	return nil
+}
+
+func (x *Pitcher) GetBf() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Bf
+	}
+	return nil
+}
+
+func (x *Pitcher) GetEraplus() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Eraplus
+	}
+	return nil
+}
+
+func (x *Pitcher) GetFip() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Fip
+	}
+	return nil
+}
+
+func (x *Pitcher) GetWhip() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Whip
+	}
+	return nil
+}
+
+func (x *Pitcher) GetH9() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.H9
+	}
+	return nil
+}
+
+func (x *Pitcher) GetHr9() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Hr9
+	}
+	return nil
+}
+
+func (x *Pitcher) GetBb9() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Bb9
+	}
+	return nil
+}
+
+func (x *Pitcher) GetSo9() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.So9
+	}
+	return nil
+}
+
+func (x *Pitcher) GetSow() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Sow
+	}
+	return nil
+}
+
+func (x *Pitcher) GetCreateddate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Createddate
+	}
+	return nil
+}
+
+// BattingSplit represents a single team batting split
+type BattingSplit struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            int64                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Teamabbrev    string                  `protobuf:"bytes,2,opt,name=teamabbrev,proto3" json:"teamabbrev,omitempty"`
+	Split         *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=split,proto3" json:"split,omitempty"`
+	G             *wrapperspb.Int64Value  `protobuf:"bytes,4,opt,name=g,proto3" json:"g,omitempty"`
+	Gs            *wrapperspb.Int64Value  `protobuf:"bytes,5,opt,name=gs,proto3" json:"gs,omitempty"`
+	Pa            *wrapperspb.Int64Value  `protobuf:"bytes,6,opt,name=pa,proto3" json:"pa,omitempty"`
+	Ab            *wrapperspb.Int64Value  `protobuf:"bytes,7,opt,name=ab,proto3" json:"ab,omitempty"`
+	R             *wrapperspb.Int64Value  `protobuf:"bytes,8,opt,name=r,proto3" json:"r,omitempty"`
+	H             *wrapperspb.Int64Value  `protobuf:"bytes,9,opt,name=h,proto3" json:"h,omitempty"`
+	Twob          *wrapperspb.Int64Value  `protobuf:"bytes,10,opt,name=twob,proto3" json:"twob,omitempty"`
+	Threeb        *wrapperspb.Int64Value  `protobuf:"bytes,11,opt,name=threeb,proto3" json:"threeb,omitempty"`
+	Hr            *wrapperspb.Int64Value  `protobuf:"bytes,12,opt,name=hr,proto3" json:"hr,omitempty"`
+	Rbi           *wrapperspb.Int64Value  `protobuf:"bytes,13,opt,name=rbi,proto3" json:"rbi,omitempty"`
+	Sb            *wrapperspb.Int64Value  `protobuf:"bytes,14,opt,name=sb,proto3" json:"sb,omitempty"`
+	Cs            *wrapperspb.Int64Value  `protobuf:"bytes,15,opt,name=cs,proto3" json:"cs,omitempty"`
+	Bb            *wrapperspb.Int64Value  `protobuf:"bytes,16,opt,name=bb,proto3" json:"bb,omitempty"`
+	So            *wrapperspb.Int64Value  `protobuf:"bytes,17,opt,name=so,proto3" json:"so,omitempty"`
+	Ba            *wrapperspb.DoubleValue `protobuf:"bytes,18,opt,name=ba,proto3" json:"ba,omitempty"`
+	Obp           *wrapperspb.DoubleValue `protobuf:"bytes,19,opt,name=obp,proto3" json:"obp,omitempty"`
+	Slg           *wrapperspb.DoubleValue `protobuf:"bytes,20,opt,name=slg,proto3" json:"slg,omitempty"`
+	Ops           *wrapperspb.DoubleValue `protobuf:"bytes,21,opt,name=ops,proto3" json:"ops,omitempty"`
+	Tb            *wrapperspb.Int64Value  `protobuf:"bytes,22,opt,name=tb,proto3" json:"tb,omitempty"`
+	Gdp           *wrapperspb.Int64Value  `protobuf:"bytes,23,opt,name=gdp,proto3" json:"gdp,omitempty"`
+	Hbp           *wrapperspb.Int64Value  `protobuf:"bytes,24,opt,name=hbp,proto3" json:"hbp,omitempty"`
+	Sh            *wrapperspb.Int64Value  `protobuf:"bytes,25,opt,name=sh,proto3" json:"sh,omitempty"`
+	Sf            *wrapperspb.Int64Value  `protobuf:"bytes,26,opt,name=sf,proto3" json:"sf,omitempty"`
+	Ibb           *wrapperspb.Int64Value  `protobuf:"bytes,27,opt,name=ibb,proto3" json:"ibb,omitempty"`
+	Roe           *wrapperspb.Int64Value  `protobuf:"bytes,28,opt,name=roe,proto3" json:"roe,omitempty"`
+	Babip         *wrapperspb.DoubleValue `protobuf:"bytes,29,opt,name=babip,proto3" json:"babip,omitempty"`
+	Topsplus      *wrapperspb.Int64Value  `protobuf:"bytes,30,opt,name=topsplus,proto3" json:"topsplus,omitempty"`
+	Sopsplus      *wrapperspb.Int64Value  `protobuf:"bytes,31,opt,name=sopsplus,proto3" json:"sopsplus,omitempty"`
+	Createddate   *timestamppb.Timestamp  `protobuf:"bytes,32,opt,name=createddate,proto3" json:"createddate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BattingSplit) Reset() {
+	*x = BattingSplit{}
+	mi := &file_rpc_sportsdata_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BattingSplit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BattingSplit) ProtoMessage() {}
+
+func (x *BattingSplit) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sportsdata_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BattingSplit.ProtoReflect.Descriptor instead.
+func (*BattingSplit) Descriptor() ([]byte, []int) {
+	return file_rpc_sportsdata_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BattingSplit) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BattingSplit) GetTeamabbrev() string {
+	if x != nil {
+		return x.Teamabbrev
+	}
+	return ""
+}
+
+func (x *BattingSplit) GetSplit() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Split
+	}
+	return nil
+}
+
+func (x *BattingSplit) GetG() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.G
+	}
+	return nil
+}
+
+func (x *BattingSplit) GetGs() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Gs
+	}
+	return nil
+}
+
+func (x *BattingSplit) GetPa() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Pa
+	}
+	return nil
+}
+
+func (x *BattingSplit) GetAb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Ab
+	}
+	return nil
+}
+
+func (x *BattingSplit) GetR() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.R
+	}
+	return nil
+}
+
+func (x *BattingSplit) GetH() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.H
+	}
+	return nil
+}
+
+func (x *BattingSplit) GetTwob() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Twob
+	}
+	return nil
+}
+
+func (x *BattingSplit) GetThreeb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Threeb
+	}
+	return nil
+}
+
+func (x *BattingSplit) GetHr() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Hr
+	}
+	return nil
+}
+
+func (x *BattingSplit) GetRbi() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Rbi
+	}
+	return nil
+}
+
+func (x *BattingSplit) GetSb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Sb
+	}
+	return nil
+}
+
+func (x *BattingSplit) GetCs() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Cs
+	}
+	return nil
+}
+
+func (x *BattingSplit) GetBb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Bb
+	}
+	return nil
+}
+
+func (x *BattingSplit) GetSo() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.So
+	}
+	return nil
+}
+
+func (x *BattingSplit) GetBa() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Ba
+	}
+	return nil
+}
+
+func (x *BattingSplit) GetObp() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Obp
+	}
+	return nil
+}
+
+func (x *BattingSplit) GetSlg() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Slg
+	}
+	return nil
+}
+
+func (x *BattingSplit) GetOps() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Ops
+	}
+	return nil
+}
+
+func (x *BattingSplit) GetTb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Tb
+	}
+	return nil
+}
+
+func (x *BattingSplit) GetGdp() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Gdp
+	}
+	return nil
+}
+
+func (x *BattingSplit) GetHbp() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Hbp
+	}
+	return nil
+}
+
+func (x *BattingSplit) GetSh() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Sh
+	}
+	return nil
+}
+
+func (x *BattingSplit) GetSf() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Sf
+	}
+	return nil
+}
+
+func (x *BattingSplit) GetIbb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Ibb
+	}
+	return nil
+}
+
+func (x *BattingSplit) GetRoe() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Roe
+	}
+	return nil
+}
+
+func (x *BattingSplit) GetBabip() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Babip
+	}
+	return nil
+}
+
+func (x *BattingSplit) GetTopsplus() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Topsplus
+	}
+	return nil
+}
+
+func (x *BattingSplit) GetSopsplus() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Sopsplus
+	}
+	return nil
+}
+
+func (x *BattingSplit) GetCreateddate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Createddate
+	}
+	return nil
+}
+
+// PitchingSplit represents a single team pitching split
+type PitchingSplit struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            int64                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Teamabbrev    string                  `protobuf:"bytes,2,opt,name=teamabbrev,proto3" json:"teamabbrev,omitempty"`
+	Split         *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=split,proto3" json:"split,omitempty"`
+	G             *wrapperspb.Int64Value  `protobuf:"bytes,4,opt,name=g,proto3" json:"g,omitempty"`
+	Pa            *wrapperspb.Int64Value  `protobuf:"bytes,5,opt,name=pa,proto3" json:"pa,omitempty"`
+	Ab            *wrapperspb.Int64Value  `protobuf:"bytes,6,opt,name=ab,proto3" json:"ab,omitempty"`
+	R             *wrapperspb.Int64Value  `protobuf:"bytes,7,opt,name=r,proto3" json:"r,omitempty"`
+	H             *wrapperspb.Int64Value  `protobuf:"bytes,8,opt,name=h,proto3" json:"h,omitempty"`
+	Twob          *wrapperspb.Int64Value  `protobuf:"bytes,9,opt,name=twob,proto3" json:"twob,omitempty"`
+	Threeb        *wrapperspb.Int64Value  `protobuf:"bytes,10,opt,name=threeb,proto3" json:"threeb,omitempty"`
+	Hr            *wrapperspb.Int64Value  `protobuf:"bytes,11,opt,name=hr,proto3" json:"hr,omitempty"`
+	Sb            *wrapperspb.Int64Value  `protobuf:"bytes,12,opt,name=sb,proto3" json:"sb,omitempty"`
+	Cs            *wrapperspb.Int64Value  `protobuf:"bytes,13,opt,name=cs,proto3" json:"cs,omitempty"`
+	Bb            *wrapperspb.Int64Value  `protobuf:"bytes,14,opt,name=bb,proto3" json:"bb,omitempty"`
+	So            *wrapperspb.Int64Value  `protobuf:"bytes,15,opt,name=so,proto3" json:"so,omitempty"`
+	Sow           *wrapperspb.DoubleValue `protobuf:"bytes,16,opt,name=sow,proto3" json:"sow,omitempty"`
+	Ba            *wrapperspb.DoubleValue `protobuf:"bytes,17,opt,name=ba,proto3" json:"ba,omitempty"`
+	Obp           *wrapperspb.DoubleValue `protobuf:"bytes,18,opt,name=obp,proto3" json:"obp,omitempty"`
+	Slg           *wrapperspb.DoubleValue `protobuf:"bytes,19,opt,name=slg,proto3" json:"slg,omitempty"`
+	Ops           *wrapperspb.DoubleValue `protobuf:"bytes,20,opt,name=ops,proto3" json:"ops,omitempty"`
+	Tb            *wrapperspb.Int64Value  `protobuf:"bytes,21,opt,name=tb,proto3" json:"tb,omitempty"`
+	Gdp           *wrapperspb.Int64Value  `protobuf:"bytes,22,opt,name=gdp,proto3" json:"gdp,omitempty"`
+	Hbp           *wrapperspb.Int64Value  `protobuf:"bytes,23,opt,name=hbp,proto3" json:"hbp,omitempty"`
+	Sh            *wrapperspb.Int64Value  `protobuf:"bytes,24,opt,name=sh,proto3" json:"sh,omitempty"`
+	Sf            *wrapperspb.Int64Value  `protobuf:"bytes,25,opt,name=sf,proto3" json:"sf,omitempty"`
+	Ibb           *wrapperspb.Int64Value  `protobuf:"bytes,26,opt,name=ibb,proto3" json:"ibb,omitempty"`
+	Roe           *wrapperspb.Int64Value  `protobuf:"bytes,27,opt,name=roe,proto3" json:"roe,omitempty"`
+	Babip         *wrapperspb.DoubleValue `protobuf:"bytes,28,opt,name=babip,proto3" json:"babip,omitempty"`
+	Topsplus      *wrapperspb.Int64Value  `protobuf:"bytes,29,opt,name=topsplus,proto3" json:"topsplus,omitempty"`
+	Sopsplus      *wrapperspb.Int64Value  `protobuf:"bytes,30,opt,name=sopsplus,proto3" json:"sopsplus,omitempty"`
+	Createddate   *timestamppb.Timestamp  `protobuf:"bytes,31,opt,name=createddate,proto3" json:"createddate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PitchingSplit) Reset() {
+	*x = PitchingSplit{}
+	mi := &file_rpc_sportsdata_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PitchingSplit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PitchingSplit) ProtoMessage() {}
+
+func (x *PitchingSplit) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sportsdata_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PitchingSplit.ProtoReflect.Descriptor instead.
+func (*PitchingSplit) Descriptor() ([]byte, []int) {
+	return file_rpc_sportsdata_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PitchingSplit) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PitchingSplit) GetTeamabbrev() string {
+	if x != nil {
+		return x.Teamabbrev
+	}
+	return ""
+}
+
+func (x *PitchingSplit) GetSplit() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Split
+	}
+	return nil
+}
+
+func (x *PitchingSplit) GetG() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.G
+	}
+	return nil
+}
+
+func (x *PitchingSplit) GetPa() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Pa
+	}
+	return nil
+}
+
+func (x *PitchingSplit) GetAb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Ab
+	}
+	return nil
+}
+
+func (x *PitchingSplit) GetR() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.R
+	}
+	return nil
+}
+
+func (x *PitchingSplit) GetH() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.H
+	}
+	return nil
+}
+
+func (x *PitchingSplit) GetTwob() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Twob
+	}
+	return nil
+}
+
+func (x *PitchingSplit) GetThreeb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Threeb
+	}
+	return nil
+}
+
+func (x *PitchingSplit) GetHr() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Hr
+	}
+	return nil
+}
+
+func (x *PitchingSplit) GetSb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Sb
+	}
+	return nil
+}
+
+func (x *PitchingSplit) GetCs() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Cs
+	}
+	return nil
+}
+
+func (x *PitchingSplit) GetBb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Bb
+	}
+	return nil
+}
+
+func (x *PitchingSplit) GetSo() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.So
+	}
+	return nil
+}
+
+func (x *PitchingSplit) GetSow() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Sow
+	}
+	return nil
+}
+
+func (x *PitchingSplit) GetBa() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Ba
+	}
+	return nil
+}
+
+func (x *PitchingSplit) GetObp() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Obp
+	}
+	return nil
+}
+
+func (x *PitchingSplit) GetSlg() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Slg
+	}
+	return nil
+}
+
+func (x *PitchingSplit) GetOps() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Ops
+	}
+	return nil
+}
+
+func (x *PitchingSplit) GetTb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Tb
+	}
+	return nil
+}
+
+func (x *PitchingSplit) GetGdp() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Gdp
+	}
+	return nil
+}
+
+func (x *PitchingSplit) GetHbp() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Hbp
+	}
+	return nil
+}
+
+func (x *PitchingSplit) GetSh() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Sh
+	}
+	return nil
+}
+
+func (x *PitchingSplit) GetSf() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Sf
+	}
+	return nil
+}
+
+func (x *PitchingSplit) GetIbb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Ibb
+	}
+	return nil
+}
+
+func (x *PitchingSplit) GetRoe() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Roe
+	}
+	return nil
+}
+
+func (x *PitchingSplit) GetBabip() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Babip
+	}
+	return nil
+}
+
+func (x *PitchingSplit) GetTopsplus() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Topsplus
+	}
+	return nil
+}
+
+func (x *PitchingSplit) GetSopsplus() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Sopsplus
+	}
+	return nil
+}
+
+func (x *PitchingSplit) GetCreateddate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Createddate
+	}
+	return nil
+}
+
+// Baserunner represents data for a single baserunner
+type Baserunner struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            int64                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Teamabbrev    string                  `protobuf:"bytes,2,opt,name=teamabbrev,proto3" json:"teamabbrev,omitempty"`
+	Name          *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Age           *wrapperspb.Int64Value  `protobuf:"bytes,4,opt,name=age,proto3" json:"age,omitempty"`
+	Pa            *wrapperspb.Int64Value  `protobuf:"bytes,5,opt,name=pa,proto3" json:"pa,omitempty"`
+	Roe           *wrapperspb.Int64Value  `protobuf:"bytes,6,opt,name=roe,proto3" json:"roe,omitempty"`
+	Xi            *wrapperspb.Int64Value  `protobuf:"bytes,7,opt,name=xi,proto3" json:"xi,omitempty"`
+	Rspct         *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=rspct,proto3" json:"rspct,omitempty"`
+	Sbo           *wrapperspb.Int64Value  `protobuf:"bytes,9,opt,name=sbo,proto3" json:"sbo,omitempty"`
+	Sb            *wrapperspb.Int64Value  `protobuf:"bytes,10,opt,name=sb,proto3" json:"sb,omitempty"`
+	Cs            *wrapperspb.Int64Value  `protobuf:"bytes,11,opt,name=cs,proto3" json:"cs,omitempty"`
+	Sbpct         *wrapperspb.StringValue `protobuf:"bytes,12,opt,name=sbpct,proto3" json:"sbpct,omitempty"`
+	Sb2           *wrapperspb.Int64Value  `protobuf:"bytes,13,opt,name=sb2,proto3" json:"sb2,omitempty"`
+	Cs2           *wrapperspb.Int64Value  `protobuf:"bytes,14,opt,name=cs2,proto3" json:"cs2,omitempty"`
+	Sb3           *wrapperspb.Int64Value  `protobuf:"bytes,15,opt,name=sb3,proto3" json:"sb3,omitempty"`
+	Cs3           *wrapperspb.Int64Value  `protobuf:"bytes,16,opt,name=cs3,proto3" json:"cs3,omitempty"`
+	Sbh           *wrapperspb.Int64Value  `protobuf:"bytes,17,opt,name=sbh,proto3" json:"sbh,omitempty"`
+	Csh           *wrapperspb.Int64Value  `protobuf:"bytes,18,opt,name=csh,proto3" json:"csh,omitempty"`
+	Po            *wrapperspb.Int64Value  `protobuf:"bytes,19,opt,name=po,proto3" json:"po,omitempty"`
+	Pcs           *wrapperspb.Int64Value  `protobuf:"bytes,20,opt,name=pcs,proto3" json:"pcs,omitempty"`
+	Oob           *wrapperspb.Int64Value  `protobuf:"bytes,21,opt,name=oob,proto3" json:"oob,omitempty"`
+	Oob1          *wrapperspb.Int64Value  `protobuf:"bytes,22,opt,name=oob1,proto3" json:"oob1,omitempty"`
+	Oob2          *wrapperspb.Int64Value  `protobuf:"bytes,23,opt,name=oob2,proto3" json:"oob2,omitempty"`
+	Oob3          *wrapperspb.Int64Value  `protobuf:"bytes,24,opt,name=oob3,proto3" json:"oob3,omitempty"`
+	Oobhm         *wrapperspb.Int64Value  `protobuf:"bytes,25,opt,name=oobhm,proto3" json:"oobhm,omitempty"`
+	Bt            *wrapperspb.Int64Value  `protobuf:"bytes,26,opt,name=bt,proto3" json:"bt,omitempty"`
+	Xbtpct        *wrapperspb.StringValue `protobuf:"bytes,27,opt,name=xbtpct,proto3" json:"xbtpct,omitempty"`
+	Firsts        *wrapperspb.Int64Value  `protobuf:"bytes,28,opt,name=firsts,proto3" json:"firsts,omitempty"`
+	Firsts2       *wrapperspb.Int64Value  `protobuf:"bytes,29,opt,name=firsts2,proto3" json:"firsts2,omitempty"`
+	Firsts3       *wrapperspb.Int64Value  `protobuf:"bytes,30,opt,name=firsts3,proto3" json:"firsts3,omitempty"`
+	Firstd        *wrapperspb.Int64Value  `protobuf:"bytes,31,opt,name=firstd,proto3" json:"firstd,omitempty"`
+	Firstd3       *wrapperspb.Int64Value  `protobuf:"bytes,32,opt,name=firstd3,proto3" json:"firstd3,omitempty"`
+	Firstdh       *wrapperspb.Int64Value  `protobuf:"bytes,33,opt,name=firstdh,proto3" json:"firstdh,omitempty"`
+	Seconds       *wrapperspb.Int64Value  `protobuf:"bytes,34,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	Seconds3      *wrapperspb.Int64Value  `protobuf:"bytes,35,opt,name=seconds3,proto3" json:"seconds3,omitempty"`
+	Secondsh      *wrapperspb.Int64Value  `protobuf:"bytes,36,opt,name=secondsh,proto3" json:"secondsh,omitempty"`
+	Createddate   *timestamppb.Timestamp  `protobuf:"bytes,37,opt,name=createddate,proto3" json:"createddate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Baserunner) Reset() {
+	*x = Baserunner{}
+	mi := &file_rpc_sportsdata_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Baserunner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Baserunner) ProtoMessage() {}
+
+func (x *Baserunner) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sportsdata_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Baserunner.ProtoReflect.Descriptor instead.
+func (*Baserunner) Descriptor() ([]byte, []int) {
+	return file_rpc_sportsdata_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Baserunner) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Baserunner) GetTeamabbrev() string {
+	if x != nil {
+		return x.Teamabbrev
+	}
+	return ""
+}
+
+func (x *Baserunner) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *Baserunner) GetAge() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+func (x *Baserunner) GetPa() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Pa
+	}
+	return nil
+}
+
+func (x *Baserunner) GetRoe() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Roe
+	}
+	return nil
+}
+
+func (x *Baserunner) GetXi() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Xi
+	}
+	return nil
+}
+
+func (x *Baserunner) GetRspct() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Rspct
+	}
+	return nil
+}
+
+func (x *Baserunner) GetSbo() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Sbo
+	}
+	return nil
+}
+
+func (x *Baserunner) GetSb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Sb
+	}
+	return nil
+}
+
+func (x *Baserunner) GetCs() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Cs
+	}
+	return nil
+}
+
+func (x *Baserunner) GetSbpct() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Sbpct
+	}
+	return nil
+}
+
+func (x *Baserunner) GetSb2() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Sb2
+	}
+	return nil
+}
+
+func (x *Baserunner) GetCs2() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Cs2
+	}
+	return nil
+}
+
+func (x *Baserunner) GetSb3() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Sb3
+	}
+	return nil
+}
+
+func (x *Baserunner) GetCs3() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Cs3
+	}
+	return nil
+}
+
+func (x *Baserunner) GetSbh() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Sbh
+	}
+	return nil
+}
+
+func (x *Baserunner) GetCsh() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Csh
+	}
+	return nil
+}
+
+func (x *Baserunner) GetPo() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Po
+	}
+	return nil
+}
+
+func (x *Baserunner) GetPcs() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Pcs
+	}
+	return nil
+}
+
+func (x *Baserunner) GetOob() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Oob
+	}
+	return nil
+}
+
+func (x *Baserunner) GetOob1() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Oob1
+	}
+	return nil
+}
+
+func (x *Baserunner) GetOob2() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Oob2
+	}
+	return nil
+}
+
+func (x *Baserunner) GetOob3() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Oob3
+	}
+	return nil
+}
+
+func (x *Baserunner) GetOobhm() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Oobhm
+	}
+	return nil
+}
+
+func (x *Baserunner) GetBt() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Bt
+	}
+	return nil
+}
+
+func (x *Baserunner) GetXbtpct() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Xbtpct
+	}
+	return nil
+}
+
+func (x *Baserunner) GetFirsts() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Firsts
+	}
+	return nil
+}
+
+func (x *Baserunner) GetFirsts2() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Firsts2
+	}
+	return nil
+}
+
+func (x *Baserunner) GetFirsts3() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Firsts3
+	}
+	return nil
+}
+
+func (x *Baserunner) GetFirstd() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Firstd
+	}
+	return nil
+}
+
+func (x *Baserunner) GetFirstd3() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Firstd3
+	}
+	return nil
+}
+
+func (x *Baserunner) GetFirstdh() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Firstdh
+	}
+	return nil
+}
+
+func (x *Baserunner) GetSeconds() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Seconds
+	}
+	return nil
+}
+
+func (x *Baserunner) GetSeconds3() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Seconds3
+	}
+	return nil
+}
+
+func (x *Baserunner) GetSecondsh() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Secondsh
+	}
+	return nil
+}
+
+func (x *Baserunner) GetCreateddate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Createddate
+	}
+	return nil
+}
+
+// BattingPitching represents opponent batting against a single pitcher
+type BattingPitching struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            int64                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Teamabbrev    string                  `protobuf:"bytes,2,opt,name=teamabbrev,proto3" json:"teamabbrev,omitempty"`
+	Rk            int64                   `protobuf:"varint,3,opt,name=rk,proto3" json:"rk,omitempty"`
+	Name          *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Age           *wrapperspb.Int64Value  `protobuf:"bytes,5,opt,name=age,proto3" json:"age,omitempty"`
+	Ip            *wrapperspb.DoubleValue `protobuf:"bytes,6,opt,name=ip,proto3" json:"ip,omitempty"`
+	G             *wrapperspb.Int64Value  `protobuf:"bytes,7,opt,name=g,proto3" json:"g,omitempty"`
+	Pa            *wrapperspb.Int64Value  `protobuf:"bytes,8,opt,name=pa,proto3" json:"pa,omitempty"`
+	Ab            *wrapperspb.Int64Value  `protobuf:"bytes,9,opt,name=ab,proto3" json:"ab,omitempty"`
+	R             *wrapperspb.Int64Value  `protobuf:"bytes,10,opt,name=r,proto3" json:"r,omitempty"`
+	H             *wrapperspb.Int64Value  `protobuf:"bytes,11,opt,name=h,proto3" json:"h,omitempty"`
+	Twob          *wrapperspb.Int64Value  `protobuf:"bytes,12,opt,name=twob,proto3" json:"twob,omitempty"`
+	Threeb        *wrapperspb.Int64Value  `protobuf:"bytes,13,opt,name=threeb,proto3" json:"threeb,omitempty"`
+	Hr            *wrapperspb.Int64Value  `protobuf:"bytes,14,opt,name=hr,proto3" json:"hr,omitempty"`
+	Sb            *wrapperspb.Int64Value  `protobuf:"bytes,15,opt,name=sb,proto3" json:"sb,omitempty"`
+	Cs            *wrapperspb.Int64Value  `protobuf:"bytes,16,opt,name=cs,proto3" json:"cs,omitempty"`
+	Bb            *wrapperspb.Int64Value  `protobuf:"bytes,17,opt,name=bb,proto3" json:"bb,omitempty"`
+	So            *wrapperspb.Int64Value  `protobuf:"bytes,18,opt,name=so,proto3" json:"so,omitempty"`
+	Ba            *wrapperspb.DoubleValue `protobuf:"bytes,19,opt,name=ba,proto3" json:"ba,omitempty"`
+	Obp           *wrapperspb.DoubleValue `protobuf:"bytes,20,opt,name=obp,proto3" json:"obp,omitempty"`
+	Slg           *wrapperspb.DoubleValue `protobuf:"bytes,21,opt,name=slg,proto3" json:"slg,omitempty"`
+	Ops           *wrapperspb.DoubleValue `protobuf:"bytes,22,opt,name=ops,proto3" json:"ops,omitempty"`
+	Tb            *wrapperspb.Int64Value  `protobuf:"bytes,23,opt,name=tb,proto3" json:"tb,omitempty"`
+	Gdp           *wrapperspb.Int64Value  `protobuf:"bytes,24,opt,name=gdp,proto3" json:"gdp,omitempty"`
+	Hbp           *wrapperspb.Int64Value  `protobuf:"bytes,25,opt,name=hbp,proto3" json:"hbp,omitempty"`
+	Sh            *wrapperspb.Int64Value  `protobuf:"bytes,26,opt,name=sh,proto3" json:"sh,omitempty"`
+	Sf            *wrapperspb.Int64Value  `protobuf:"bytes,27,opt,name=sf,proto3" json:"sf,omitempty"`
+	Ibb           *wrapperspb.Int64Value  `protobuf:"bytes,28,opt,name=ibb,proto3" json:"ibb,omitempty"`
+	Roe           *wrapperspb.Int64Value  `protobuf:"bytes,29,opt,name=roe,proto3" json:"roe,omitempty"`
+	Babip         *wrapperspb.DoubleValue `protobuf:"bytes,30,opt,name=babip,proto3" json:"babip,omitempty"`
+	Createddate   *timestamppb.Timestamp  `protobuf:"bytes,31,opt,name=createddate,proto3" json:"createddate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BattingPitching) Reset() {
+	*x = BattingPitching{}
+	mi := &file_rpc_sportsdata_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BattingPitching) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BattingPitching) ProtoMessage() {}
+
+func (x *BattingPitching) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sportsdata_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BattingPitching.ProtoReflect.Descriptor instead.
+func (*BattingPitching) Descriptor() ([]byte, []int) {
+	return file_rpc_sportsdata_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BattingPitching) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BattingPitching) GetTeamabbrev() string {
+	if x != nil {
+		return x.Teamabbrev
+	}
+	return ""
+}
+
+func (x *BattingPitching) GetRk() int64 {
+	if x != nil {
+		return x.Rk
+	}
+	return 0
+}
+
+func (x *BattingPitching) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *BattingPitching) GetAge() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+func (x *BattingPitching) GetIp() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Ip
+	}
+	return nil
+}
+
+func (x *BattingPitching) GetG() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.G
+	}
+	return nil
+}
+
+func (x *BattingPitching) GetPa() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Pa
+	}
+	return nil
+}
+
+func (x *BattingPitching) GetAb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Ab
+	}
+	return nil
+}
+
+func (x *BattingPitching) GetR() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.R
+	}
+	return nil
+}
+
+func (x *BattingPitching) GetH() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.H
+	}
+	return nil
+}
+
+func (x *BattingPitching) GetTwob() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Twob
+	}
+	return nil
+}
+
+func (x *BattingPitching) GetThreeb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Threeb
+	}
+	return nil
+}
+
+func (x *BattingPitching) GetHr() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Hr
+	}
+	return nil
+}
+
+func (x *BattingPitching) GetSb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Sb
+	}
+	return nil
+}
+
+func (x *BattingPitching) GetCs() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Cs
+	}
+	return nil
+}
+
+func (x *BattingPitching) GetBb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Bb
+	}
+	return nil
+}
+
+func (x *BattingPitching) GetSo() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.So
+	}
+	return nil
+}
+
+func (x *BattingPitching) GetBa() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Ba
+	}
+	return nil
+}
+
+func (x *BattingPitching) GetObp() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Obp
+	}
+	return nil
+}
+
+func (x *BattingPitching) GetSlg() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Slg
+	}
+	return nil
+}
+
+func (x *BattingPitching) GetOps() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Ops
+	}
+	return nil
+}
+
+func (x *BattingPitching) GetTb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Tb
+	}
+	return nil
+}
+
+func (x *BattingPitching) GetGdp() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Gdp
+	}
+	return nil
+}
+
+func (x *BattingPitching) GetHbp() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Hbp
+	}
+	return nil
+}
+
+func (x *BattingPitching) GetSh() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Sh
+	}
+	return nil
+}
+
+func (x *BattingPitching) GetSf() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Sf
+	}
+	return nil
+}
+
+func (x *BattingPitching) GetIbb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Ibb
+	}
+	return nil
+}
+
+func (x *BattingPitching) GetRoe() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Roe
+	}
+	return nil
+}
+
+func (x *BattingPitching) GetBabip() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Babip
+	}
+	return nil
+}
+
+func (x *BattingPitching) GetCreateddate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Createddate
+	}
+	return nil
+}
+
+// Snapshot represents one createddate snapshot of a table for a team
+type Snapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tablename     string                 `protobuf:"bytes,1,opt,name=tablename,proto3" json:"tablename,omitempty"`
+	Teamabbrev    string                 `protobuf:"bytes,2,opt,name=teamabbrev,proto3" json:"teamabbrev,omitempty"`
+	Createddate   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=createddate,proto3" json:"createddate,omitempty"`
+	Rowcount      int64                  `protobuf:"varint,4,opt,name=rowcount,proto3" json:"rowcount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Snapshot) Reset() {
+	*x = Snapshot{}
+	mi := &file_rpc_sportsdata_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Snapshot) ProtoMessage() {}
+
+func (x *Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sportsdata_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Snapshot.ProtoReflect.Descriptor instead.
+func (*Snapshot) Descriptor() ([]byte, []int) {
+	return file_rpc_sportsdata_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Snapshot) GetTablename() string {
+	if x != nil {
+		return x.Tablename
+	}
+	return ""
+}
+
+func (x *Snapshot) GetTeamabbrev() string {
+	if x != nil {
+		return x.Teamabbrev
+	}
+	return ""
+}
+
+func (x *Snapshot) GetCreateddate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Createddate
+	}
+	return nil
+}
+
+func (x *Snapshot) GetRowcount() int64 {
+	if x != nil {
+		return x.Rowcount
+	}
+	return 0
+}
+
+// BattingDelta represents the change in a batter's counting stats between two snapshots with rate stats
+// recomputed over just that window
+type BattingDelta struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Name          *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Teamabbrev    string                  `protobuf:"bytes,2,opt,name=teamabbrev,proto3" json:"teamabbrev,omitempty"`
+	Fromdate      *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=fromdate,proto3" json:"fromdate,omitempty"`
+	Todate        *timestamppb.Timestamp  `protobuf:"bytes,4,opt,name=todate,proto3" json:"todate,omitempty"`
+	G             *wrapperspb.Int64Value  `protobuf:"bytes,5,opt,name=g,proto3" json:"g,omitempty"`
+	Pa            *wrapperspb.Int64Value  `protobuf:"bytes,6,opt,name=pa,proto3" json:"pa,omitempty"`
+	Ab            *wrapperspb.Int64Value  `protobuf:"bytes,7,opt,name=ab,proto3" json:"ab,omitempty"`
+	R             *wrapperspb.Int64Value  `protobuf:"bytes,8,opt,name=r,proto3" json:"r,omitempty"`
+	H             *wrapperspb.Int64Value  `protobuf:"bytes,9,opt,name=h,proto3" json:"h,omitempty"`
+	Twob          *wrapperspb.Int64Value  `protobuf:"bytes,10,opt,name=twob,proto3" json:"twob,omitempty"`
+	Threeb        *wrapperspb.Int64Value  `protobuf:"bytes,11,opt,name=threeb,proto3" json:"threeb,omitempty"`
+	Hr            *wrapperspb.Int64Value  `protobuf:"bytes,12,opt,name=hr,proto3" json:"hr,omitempty"`
+	Rbi           *wrapperspb.Int64Value  `protobuf:"bytes,13,opt,name=rbi,proto3" json:"rbi,omitempty"`
+	Sb            *wrapperspb.Int64Value  `protobuf:"bytes,14,opt,name=sb,proto3" json:"sb,omitempty"`
+	Cs            *wrapperspb.Int64Value  `protobuf:"bytes,15,opt,name=cs,proto3" json:"cs,omitempty"`
+	Bb            *wrapperspb.Int64Value  `protobuf:"bytes,16,opt,name=bb,proto3" json:"bb,omitempty"`
+	So            *wrapperspb.Int64Value  `protobuf:"bytes,17,opt,name=so,proto3" json:"so,omitempty"`
+	Tb            *wrapperspb.Int64Value  `protobuf:"bytes,18,opt,name=tb,proto3" json:"tb,omitempty"`
+	Gdp           *wrapperspb.Int64Value  `protobuf:"bytes,19,opt,name=gdp,proto3" json:"gdp,omitempty"`
+	Hbp           *wrapperspb.Int64Value  `protobuf:"bytes,20,opt,name=hbp,proto3" json:"hbp,omitempty"`
+	Sh            *wrapperspb.Int64Value  `protobuf:"bytes,21,opt,name=sh,proto3" json:"sh,omitempty"`
+	Sf            *wrapperspb.Int64Value  `protobuf:"bytes,22,opt,name=sf,proto3" json:"sf,omitempty"`
+	Ibb           *wrapperspb.Int64Value  `protobuf:"bytes,23,opt,name=ibb,proto3" json:"ibb,omitempty"`
+	Ba            *wrapperspb.DoubleValue `protobuf:"bytes,24,opt,name=ba,proto3" json:"ba,omitempty"`
+	Obp           *wrapperspb.DoubleValue `protobuf:"bytes,25,opt,name=obp,proto3" json:"obp,omitempty"`
+	Slg           *wrapperspb.DoubleValue `protobuf:"bytes,26,opt,name=slg,proto3" json:"slg,omitempty"`
+	Ops           *wrapperspb.DoubleValue `protobuf:"bytes,27,opt,name=ops,proto3" json:"ops,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BattingDelta) Reset() {
+	*x = BattingDelta{}
+	mi := &file_rpc_sportsdata_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BattingDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BattingDelta) ProtoMessage() {}
+
+func (x *BattingDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sportsdata_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BattingDelta.ProtoReflect.Descriptor instead.
+func (*BattingDelta) Descriptor() ([]byte, []int) {
+	return file_rpc_sportsdata_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *BattingDelta) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *BattingDelta) GetTeamabbrev() string {
+	if x != nil {
+		return x.Teamabbrev
+	}
+	return ""
+}
+
+func (x *BattingDelta) GetFromdate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Fromdate
+	}
+	return nil
+}
+
+func (x *BattingDelta) GetTodate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Todate
+	}
+	return nil
+}
+
+func (x *BattingDelta) GetG() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.G
+	}
+	return nil
+}
+
+func (x *BattingDelta) GetPa() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Pa
+	}
+	return nil
+}
+
+func (x *BattingDelta) GetAb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Ab
+	}
+	return nil
+}
+
+func (x *BattingDelta) GetR() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.R
+	}
+	return nil
+}
+
+func (x *BattingDelta) GetH() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.H
+	}
+	return nil
+}
+
+func (x *BattingDelta) GetTwob() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Twob
+	}
+	return nil
+}
+
+func (x *BattingDelta) GetThreeb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Threeb
+	}
+	return nil
+}
+
+func (x *BattingDelta) GetHr() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Hr
+	}
+	return nil
+}
+
+func (x *BattingDelta) GetRbi() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Rbi
+	}
+	return nil
+}
+
+func (x *BattingDelta) GetSb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Sb
+	}
+	return nil
+}
+
+func (x *BattingDelta) GetCs() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Cs
+	}
+	return nil
+}
+
+func (x *BattingDelta) GetBb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Bb
+	}
+	return nil
+}
+
+func (x *BattingDelta) GetSo() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.So
+	}
+	return nil
+}
+
+func (x *BattingDelta) GetTb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Tb
+	}
+	return nil
+}
+
+func (x *BattingDelta) GetGdp() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Gdp
+	}
+	return nil
+}
+
+func (x *BattingDelta) GetHbp() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Hbp
+	}
+	return nil
+}
+
+func (x *BattingDelta) GetSh() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Sh
+	}
+	return nil
+}
+
+func (x *BattingDelta) GetSf() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Sf
+	}
+	return nil
+}
+
+func (x *BattingDelta) GetIbb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Ibb
+	}
+	return nil
+}
+
+func (x *BattingDelta) GetBa() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Ba
+	}
+	return nil
+}
+
+func (x *BattingDelta) GetObp() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Obp
+	}
+	return nil
+}
+
+func (x *BattingDelta) GetSlg() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Slg
+	}
+	return nil
+}
+
+func (x *BattingDelta) GetOps() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Ops
+	}
+	return nil
+}
+
+// PitchingDelta represents the change in a pitcher's counting stats between two snapshots with rate stats
+// recomputed over just that window
+type PitchingDelta struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Name          *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Teamabbrev    string                  `protobuf:"bytes,2,opt,name=teamabbrev,proto3" json:"teamabbrev,omitempty"`
+	Fromdate      *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=fromdate,proto3" json:"fromdate,omitempty"`
+	Todate        *timestamppb.Timestamp  `protobuf:"bytes,4,opt,name=todate,proto3" json:"todate,omitempty"`
+	W             *wrapperspb.Int64Value  `protobuf:"bytes,5,opt,name=w,proto3" json:"w,omitempty"`
+	L             *wrapperspb.Int64Value  `protobuf:"bytes,6,opt,name=l,proto3" json:"l,omitempty"`
+	G             *wrapperspb.Int64Value  `protobuf:"bytes,7,opt,name=g,proto3" json:"g,omitempty"`
+	Gs            *wrapperspb.Int64Value  `protobuf:"bytes,8,opt,name=gs,proto3" json:"gs,omitempty"`
+	Gf            *wrapperspb.Int64Value  `protobuf:"bytes,9,opt,name=gf,proto3" json:"gf,omitempty"`
+	Cg            *wrapperspb.Int64Value  `protobuf:"bytes,10,opt,name=cg,proto3" json:"cg,omitempty"`
+	Sho           *wrapperspb.Int64Value  `protobuf:"bytes,11,opt,name=sho,proto3" json:"sho,omitempty"`
+	Sv            *wrapperspb.Int64Value  `protobuf:"bytes,12,opt,name=sv,proto3" json:"sv,omitempty"`
+	Ip            *wrapperspb.DoubleValue `protobuf:"bytes,13,opt,name=ip,proto3" json:"ip,omitempty"`
+	H             *wrapperspb.Int64Value  `protobuf:"bytes,14,opt,name=h,proto3" json:"h,omitempty"`
+	R             *wrapperspb.Int64Value  `protobuf:"bytes,15,opt,name=r,proto3" json:"r,omitempty"`
+	Er            *wrapperspb.Int64Value  `protobuf:"bytes,16,opt,name=er,proto3" json:"er,omitempty"`
+	Hr            *wrapperspb.Int64Value  `protobuf:"bytes,17,opt,name=hr,proto3" json:"hr,omitempty"`
+	Bb            *wrapperspb.Int64Value  `protobuf:"bytes,18,opt,name=bb,proto3" json:"bb,omitempty"`
+	Ibb           *wrapperspb.Int64Value  `protobuf:"bytes,19,opt,name=ibb,proto3" json:"ibb,omitempty"`
+	So            *wrapperspb.Int64Value  `protobuf:"bytes,20,opt,name=so,proto3" json:"so,omitempty"`
+	Hbp           *wrapperspb.Int64Value  `protobuf:"bytes,21,opt,name=hbp,proto3" json:"hbp,omitempty"`
+	Bk            *wrapperspb.Int64Value  `protobuf:"bytes,22,opt,name=bk,proto3" json:"bk,omitempty"`
+	Wp            *wrapperspb.Int64Value  `protobuf:"bytes,23,opt,name=wp,proto3" json:"wp,omitempty"`
+	Bf            *wrapperspb.Int64Value  `protobuf:"bytes,24,opt,name=bf,proto3" json:"bf,omitempty"`
+	Wl            *wrapperspb.DoubleValue `protobuf:"bytes,25,opt,name=wl,proto3" json:"wl,omitempty"`
+	Era           *wrapperspb.DoubleValue `protobuf:"bytes,26,opt,name=era,proto3" json:"era,omitempty"`
+	Whip          *wrapperspb.DoubleValue `protobuf:"bytes,27,opt,name=whip,proto3" json:"whip,omitempty"`
+	H9            *wrapperspb.DoubleValue `protobuf:"bytes,28,opt,name=h9,proto3" json:"h9,omitempty"`
+	Hr9           *wrapperspb.DoubleValue `protobuf:"bytes,29,opt,name=hr9,proto3" json:"hr9,omitempty"`
+	Bb9           *wrapperspb.DoubleValue `protobuf:"bytes,30,opt,name=bb9,proto3" json:"bb9,omitempty"`
+	So9           *wrapperspb.DoubleValue `protobuf:"bytes,31,opt,name=so9,proto3" json:"so9,omitempty"`
+	Sow           *wrapperspb.DoubleValue `protobuf:"bytes,32,opt,name=sow,proto3" json:"sow,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PitchingDelta) Reset() {
+	*x = PitchingDelta{}
+	mi := &file_rpc_sportsdata_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PitchingDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PitchingDelta) ProtoMessage() {}
+
+func (x *PitchingDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sportsdata_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PitchingDelta.ProtoReflect.Descriptor instead.
+func (*PitchingDelta) Descriptor() ([]byte, []int) {
+	return file_rpc_sportsdata_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PitchingDelta) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *PitchingDelta) GetTeamabbrev() string {
+	if x != nil {
+		return x.Teamabbrev
+	}
+	return ""
+}
+
+func (x *PitchingDelta) GetFromdate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Fromdate
+	}
+	return nil
+}
+
+func (x *PitchingDelta) GetTodate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Todate
+	}
+	return nil
+}
+
+func (x *PitchingDelta) GetW() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.W
+	}
+	return nil
+}
+
+func (x *PitchingDelta) GetL() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.L
+	}
+	return nil
+}
+
+func (x *PitchingDelta) GetG() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.G
+	}
+	return nil
+}
+
+func (x *PitchingDelta) GetGs() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Gs
+	}
+	return nil
+}
+
+func (x *PitchingDelta) GetGf() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Gf
+	}
+	return nil
+}
+
+func (x *PitchingDelta) GetCg() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Cg
+	}
+	return nil
+}
+
+func (x *PitchingDelta) GetSho() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Sho
+	}
+	return nil
+}
+
+func (x *PitchingDelta) GetSv() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Sv
+	}
+	return nil
+}
+
+func (x *PitchingDelta) GetIp() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Ip
+	}
+	return nil
+}
+
+func (x *PitchingDelta) GetH() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.H
+	}
+	return nil
+}
+
+func (x *PitchingDelta) GetR() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.R
+	}
+	return nil
+}
+
+func (x *PitchingDelta) GetEr() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Er
+	}
+	return nil
+}
+
+func (x *PitchingDelta) GetHr() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Hr
+	}
+	return nil
+}
+
+func (x *PitchingDelta) GetBb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Bb
+	}
+	return nil
+}
+
+func (x *PitchingDelta) GetIbb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Ibb
+	}
+	return nil
+}
+
+func (x *PitchingDelta) GetSo() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.So
+	}
+	return nil
+}
+
+func (x *PitchingDelta) GetHbp() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Hbp
+	}
+	return nil
+}
+
+func (x *PitchingDelta) GetBk() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Bk
+	}
+	return nil
+}
+
+func (x *PitchingDelta) GetWp() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Wp
+	}
+	return nil
+}
+
+func (x *PitchingDelta) GetBf() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Bf
+	}
+	return nil
+}
+
+func (x *PitchingDelta) GetWl() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Wl
+	}
+	return nil
+}
+
+func (x *PitchingDelta) GetEra() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Era
+	}
+	return nil
+}
+
+func (x *PitchingDelta) GetWhip() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Whip
+	}
+	return nil
+}
+
+func (x *PitchingDelta) GetH9() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.H9
+	}
+	return nil
+}
+
+func (x *PitchingDelta) GetHr9() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Hr9
+	}
+	return nil
+}
+
+func (x *PitchingDelta) GetBb9() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Bb9
+	}
+	return nil
+}
+
+func (x *PitchingDelta) GetSo9() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.So9
+	}
+	return nil
+}
+
+func (x *PitchingDelta) GetSow() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Sow
+	}
+	return nil
+}
+
+// BaserunnerDelta represents the change in a baserunner's counting stats between two snapshots with stolen base
+// percentage recomputed over just that window
+type BaserunnerDelta struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Name          *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Teamabbrev    string                  `protobuf:"bytes,2,opt,name=teamabbrev,proto3" json:"teamabbrev,omitempty"`
+	Fromdate      *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=fromdate,proto3" json:"fromdate,omitempty"`
+	Todate        *timestamppb.Timestamp  `protobuf:"bytes,4,opt,name=todate,proto3" json:"todate,omitempty"`
+	Pa            *wrapperspb.Int64Value  `protobuf:"bytes,5,opt,name=pa,proto3" json:"pa,omitempty"`
+	Roe           *wrapperspb.Int64Value  `protobuf:"bytes,6,opt,name=roe,proto3" json:"roe,omitempty"`
+	Xi            *wrapperspb.Int64Value  `protobuf:"bytes,7,opt,name=xi,proto3" json:"xi,omitempty"`
+	Sbo           *wrapperspb.Int64Value  `protobuf:"bytes,8,opt,name=sbo,proto3" json:"sbo,omitempty"`
+	Sb            *wrapperspb.Int64Value  `protobuf:"bytes,9,opt,name=sb,proto3" json:"sb,omitempty"`
+	Cs            *wrapperspb.Int64Value  `protobuf:"bytes,10,opt,name=cs,proto3" json:"cs,omitempty"`
+	Sb2           *wrapperspb.Int64Value  `protobuf:"bytes,11,opt,name=sb2,proto3" json:"sb2,omitempty"`
+	Cs2           *wrapperspb.Int64Value  `protobuf:"bytes,12,opt,name=cs2,proto3" json:"cs2,omitempty"`
+	Sb3           *wrapperspb.Int64Value  `protobuf:"bytes,13,opt,name=sb3,proto3" json:"sb3,omitempty"`
+	Cs3           *wrapperspb.Int64Value  `protobuf:"bytes,14,opt,name=cs3,proto3" json:"cs3,omitempty"`
+	Sbh           *wrapperspb.Int64Value  `protobuf:"bytes,15,opt,name=sbh,proto3" json:"sbh,omitempty"`
+	Csh           *wrapperspb.Int64Value  `protobuf:"bytes,16,opt,name=csh,proto3" json:"csh,omitempty"`
+	Po            *wrapperspb.Int64Value  `protobuf:"bytes,17,opt,name=po,proto3" json:"po,omitempty"`
+	Pcs           *wrapperspb.Int64Value  `protobuf:"bytes,18,opt,name=pcs,proto3" json:"pcs,omitempty"`
+	Oob           *wrapperspb.Int64Value  `protobuf:"bytes,19,opt,name=oob,proto3" json:"oob,omitempty"`
+	Oob1          *wrapperspb.Int64Value  `protobuf:"bytes,20,opt,name=oob1,proto3" json:"oob1,omitempty"`
+	Oob2          *wrapperspb.Int64Value  `protobuf:"bytes,21,opt,name=oob2,proto3" json:"oob2,omitempty"`
+	Oob3          *wrapperspb.Int64Value  `protobuf:"bytes,22,opt,name=oob3,proto3" json:"oob3,omitempty"`
+	Oobhm         *wrapperspb.Int64Value  `protobuf:"bytes,23,opt,name=oobhm,proto3" json:"oobhm,omitempty"`
+	Bt            *wrapperspb.Int64Value  `protobuf:"bytes,24,opt,name=bt,proto3" json:"bt,omitempty"`
+	Firsts        *wrapperspb.Int64Value  `protobuf:"bytes,25,opt,name=firsts,proto3" json:"firsts,omitempty"`
+	Firsts2       *wrapperspb.Int64Value  `protobuf:"bytes,26,opt,name=firsts2,proto3" json:"firsts2,omitempty"`
+	Firsts3       *wrapperspb.Int64Value  `protobuf:"bytes,27,opt,name=firsts3,proto3" json:"firsts3,omitempty"`
+	Firstd        *wrapperspb.Int64Value  `protobuf:"bytes,28,opt,name=firstd,proto3" json:"firstd,omitempty"`
+	Firstd3       *wrapperspb.Int64Value  `protobuf:"bytes,29,opt,name=firstd3,proto3" json:"firstd3,omitempty"`
+	Firstdh       *wrapperspb.Int64Value  `protobuf:"bytes,30,opt,name=firstdh,proto3" json:"firstdh,omitempty"`
+	Seconds       *wrapperspb.Int64Value  `protobuf:"bytes,31,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	Seconds3      *wrapperspb.Int64Value  `protobuf:"bytes,32,opt,name=seconds3,proto3" json:"seconds3,omitempty"`
+	Secondsh      *wrapperspb.Int64Value  `protobuf:"bytes,33,opt,name=secondsh,proto3" json:"secondsh,omitempty"`
+	Sbpct         *wrapperspb.DoubleValue `protobuf:"bytes,34,opt,name=sbpct,proto3" json:"sbpct,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BaserunnerDelta) Reset() {
+	*x = BaserunnerDelta{}
+	mi := &file_rpc_sportsdata_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BaserunnerDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BaserunnerDelta) ProtoMessage() {}
+
+func (x *BaserunnerDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sportsdata_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BaserunnerDelta.ProtoReflect.Descriptor instead.
+func (*BaserunnerDelta) Descriptor() ([]byte, []int) {
+	return file_rpc_sportsdata_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BaserunnerDelta) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *BaserunnerDelta) GetTeamabbrev() string {
+	if x != nil {
+		return x.Teamabbrev
+	}
+	return ""
+}
+
+func (x *BaserunnerDelta) GetFromdate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Fromdate
+	}
+	return nil
+}
+
+func (x *BaserunnerDelta) GetTodate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Todate
+	}
+	return nil
+}
+
+func (x *BaserunnerDelta) GetPa() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Pa
+	}
+	return nil
+}
+
+func (x *BaserunnerDelta) GetRoe() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Roe
+	}
+	return nil
+}
+
+func (x *BaserunnerDelta) GetXi() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Xi
+	}
+	return nil
+}
+
+func (x *BaserunnerDelta) GetSbo() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Sbo
+	}
+	return nil
+}
+
+func (x *BaserunnerDelta) GetSb() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Sb
+	}
+	return nil
+}
+
+func (x *BaserunnerDelta) GetCs() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Cs
+	}
+	return nil
+}
+
+func (x *BaserunnerDelta) GetSb2() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Sb2
+	}
+	return nil
+}
+
+func (x *BaserunnerDelta) GetCs2() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Cs2
+	}
+	return nil
+}
+
+func (x *BaserunnerDelta) GetSb3() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Sb3
+	}
+	return nil
+}
+
+func (x *BaserunnerDelta) GetCs3() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Cs3
+	}
+	return nil
+}
+
+func (x *BaserunnerDelta) GetSbh() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Sbh
+	}
+	return nil
+}
+
+func (x *BaserunnerDelta) GetCsh() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Csh
+	}
+	return nil
+}
+
+func (x *BaserunnerDelta) GetPo() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Po
+	}
+	return nil
+}
+
+func (x *BaserunnerDelta) GetPcs() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Pcs
+	}
+	return nil
+}
+
+func (x *BaserunnerDelta) GetOob() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Oob
+	}
+	return nil
+}
+
+func (x *BaserunnerDelta) GetOob1() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Oob1
+	}
+	return nil
+}
+
+func (x *BaserunnerDelta) GetOob2() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Oob2
+	}
+	return nil
+}
+
+func (x *BaserunnerDelta) GetOob3() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Oob3
+	}
+	return nil
+}
+
+func (x *BaserunnerDelta) GetOobhm() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Oobhm
+	}
+	return nil
+}
+
+func (x *BaserunnerDelta) GetBt() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Bt
+	}
+	return nil
+}
+
+func (x *BaserunnerDelta) GetFirsts() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Firsts
+	}
+	return nil
+}
+
+func (x *BaserunnerDelta) GetFirsts2() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Firsts2
+	}
+	return nil
+}
+
+func (x *BaserunnerDelta) GetFirsts3() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Firsts3
+	}
+	return nil
+}
+
+func (x *BaserunnerDelta) GetFirstd() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Firstd
+	}
+	return nil
+}
+
+func (x *BaserunnerDelta) GetFirstd3() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Firstd3
+	}
+	return nil
+}
+
+func (x *BaserunnerDelta) GetFirstdh() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Firstdh
+	}
+	return nil
+}
+
+func (x *BaserunnerDelta) GetSeconds() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Seconds
+	}
+	return nil
+}
+
+func (x *BaserunnerDelta) GetSeconds3() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Seconds3
+	}
+	return nil
+}
+
+func (x *BaserunnerDelta) GetSecondsh() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Secondsh
+	}
+	return nil
+}
+
+func (x *BaserunnerDelta) GetSbpct() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Sbpct
+	}
+	return nil
+}
+
+// BattingLeader represents a batter ranked across the league
+type BattingLeader struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Leaguerank    int64                  `protobuf:"varint,1,opt,name=leaguerank,proto3" json:"leaguerank,omitempty"`
+	Batter        *Batter                `protobuf:"bytes,2,opt,name=batter,proto3" json:"batter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BattingLeader) Reset() {
+	*x = BattingLeader{}
+	mi := &file_rpc_sportsdata_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BattingLeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BattingLeader) ProtoMessage() {}
+
+func (x *BattingLeader) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sportsdata_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BattingLeader.ProtoReflect.Descriptor instead.
+func (*BattingLeader) Descriptor() ([]byte, []int) {
+	return file_rpc_sportsdata_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *BattingLeader) GetLeaguerank() int64 {
+	if x != nil {
+		return x.Leaguerank
+	}
+	return 0
+}
+
+func (x *BattingLeader) GetBatter() *Batter {
+	if x != nil {
+		return x.Batter
+	}
+	return nil
+}
+
+// PitchingLeader represents a pitcher ranked across the league
+type PitchingLeader struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Leaguerank    int64                  `protobuf:"varint,1,opt,name=leaguerank,proto3" json:"leaguerank,omitempty"`
+	Pitcher       *Pitcher               `protobuf:"bytes,2,opt,name=pitcher,proto3" json:"pitcher,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PitchingLeader) Reset() {
+	*x = PitchingLeader{}
+	mi := &file_rpc_sportsdata_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PitchingLeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PitchingLeader) ProtoMessage() {}
+
+func (x *PitchingLeader) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sportsdata_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PitchingLeader.ProtoReflect.Descriptor instead.
+func (*PitchingLeader) Descriptor() ([]byte, []int) {
+	return file_rpc_sportsdata_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PitchingLeader) GetLeaguerank() int64 {
+	if x != nil {
+		return x.Leaguerank
+	}
+	return 0
+}
+
+func (x *PitchingLeader) GetPitcher() *Pitcher {
+	if x != nil {
+		return x.Pitcher
+	}
+	return nil
+}
+
+var File_rpc_sportsdata_proto protoreflect.FileDescriptor
+
+const file_rpc_sportsdata_proto_rawDesc = "" +
+	"\n" +
+	"\x14rpc/sportsdata.proto\x12\rsportsdata.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"D\n" +
+	"\x06Filter\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x0e\n" +
+	"\x02op\x18\x02 \x01(\tR\x02op\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\"\xb8\x01\n" +
+	"\vStatRequest\x12\x1e\n" +
+	"\n" +
+	"teamabbrev\x18\x01 \x01(\tR\n" +
+	"teamabbrev\x12\x12\n" +
+	"\x04asof\x18\x02 \x01(\tR\x04asof\x12\x1a\n" +
+	"\bsnapshot\x18\x03 \x01(\tR\bsnapshot\x12/\n" +
+	"\afilters\x18\x04 \x03(\v2\x15.sportsdata.v1.FilterR\afilters\x12\x12\n" +
+	"\x04sort\x18\x05 \x01(\tR\x04sort\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\"\x12\n" +
+	"\x10ListTeamsRequest\">\n" +
+	"\x11ListTeamsResponse\x12)\n" +
+	"\x05teams\x18\x01 \x03(\v2\x13.sportsdata.v1.TeamR\x05teams\"F\n" +
+	"\x13ListBattingResponse\x12/\n" +
+	"\abatters\x18\x01 \x03(\v2\x15.sportsdata.v1.BatterR\abatters\"J\n" +
+	"\x14ListPitchingResponse\x122\n" +
+	"\bpitchers\x18\x01 \x03(\v2\x16.sportsdata.v1.PitcherR\bpitchers\"P\n" +
+	"\x19ListBattingSplitsResponse\x123\n" +
+	"\x06splits\x18\x01 \x03(\v2\x1b.sportsdata.v1.BattingSplitR\x06splits\"R\n" +
+	"\x1aListPitchingSplitsResponse\x124\n" +
+	"\x06splits\x18\x01 \x03(\v2\x1c.sportsdata.v1.PitchingSplitR\x06splits\"V\n" +
+	"\x17ListBaserunningResponse\x12;\n" +
+	"\vbaserunners\x18\x01 \x03(\v2\x19.sportsdata.v1.BaserunnerR\vbaserunners\"Q\n" +
+	"\x1bListBattingPitchingResponse\x122\n" +
+	"\x04rows\x18\x01 \x03(\v2\x1e.sportsdata.v1.BattingPitchingR\x04rows\"L\n" +
+	"\x14ListSnapshotsRequest\x12\x14\n" +
+	"\x05table\x18\x01 \x01(\tR\x05table\x12\x1e\n" +
+	"\n" +
+	"teamabbrev\x18\x02 \x01(\tR\n" +
+	"teamabbrev\"N\n" +
+	"\x15ListSnapshotsResponse\x125\n" +
+	"\tsnapshots\x18\x01 \x03(\v2\x17.sportsdata.v1.SnapshotR\tsnapshots\"\x93\x01\n" +
+	"\x14PlayerHistoryRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04stat\x18\x02 \x01(\tR\x04stat\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\tR\x02to\x12/\n" +
+	"\afilters\x18\x05 \x03(\v2\x15.sportsdata.v1.FilterR\afilters\"\x84\x02\n" +
+	"\x15PlayerHistoryResponse\x12/\n" +
+	"\abatters\x18\x01 \x03(\v2\x15.sportsdata.v1.BatterR\abatters\x122\n" +
+	"\bpitchers\x18\x02 \x03(\v2\x16.sportsdata.v1.PitcherR\bpitchers\x12;\n" +
+	"\vbaserunners\x18\x03 \x03(\v2\x19.sportsdata.v1.BaserunnerR\vbaserunners\x12I\n" +
+	"\x10batting_pitching\x18\x04 \x03(\v2\x1e.sportsdata.v1.BattingPitchingR\x0fbattingPitching\"Q\n" +
+	"\vDiffRequest\x12\x1e\n" +
+	"\n" +
+	"teamabbrev\x18\x01 \x01(\tR\n" +
+	"teamabbrev\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\"J\n" +
+	"\x13DiffBattingResponse\x123\n" +
+	"\x06deltas\x18\x01 \x03(\v2\x1b.sportsdata.v1.BattingDeltaR\x06deltas\"L\n" +
+	"\x14DiffPitchingResponse\x124\n" +
+	"\x06deltas\x18\x01 \x03(\v2\x1c.sportsdata.v1.PitchingDeltaR\x06deltas\"Q\n" +
+	"\x17DiffBaserunningResponse\x126\n" +
+	"\x06deltas\x18\x01 \x03(\v2\x1e.sportsdata.v1.BaserunnerDeltaR\x06deltas\"\xad\x01\n" +
+	"\x0eLeadersRequest\x12\x12\n" +
+	"\x04asof\x18\x01 \x01(\tR\x04asof\x12\x1a\n" +
+	"\bsnapshot\x18\x02 \x01(\tR\bsnapshot\x12/\n" +
+	"\afilters\x18\x03 \x03(\v2\x15.sportsdata.v1.FilterR\afilters\x12\x12\n" +
+	"\x04sort\x18\x04 \x01(\tR\x04sort\x12\x10\n" +
+	"\x03min\x18\x05 \x01(\x01R\x03min\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\"P\n" +
+	"\x16BattingLeadersResponse\x126\n" +
+	"\aleaders\x18\x01 \x03(\v2\x1c.sportsdata.v1.BattingLeaderR\aleaders\"R\n" +
+	"\x17PitchingLeadersResponse\x127\n" +
+	"\aleaders\x18\x01 \x03(\v2\x1d.sportsdata.v1.PitchingLeaderR\aleaders\"R\n" +
+	"\x04Team\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bteamname\x18\x02 \x01(\tR\bteamname\x12\x1e\n" +
+	"\n" +
+	"teamabbrev\x18\x03 \x01(\tR\n" +
+	"teamabbrev\"\xf1\n" +
+	"\n" +
+	"\x06Batter\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1e\n" +
+	"\n" +
+	"teamabbrev\x18\x02 \x01(\tR\n" +
+	"teamabbrev\x12\x0e\n" +
+	"\x02rk\x18\x03 \x01(\x03R\x02rk\x12.\n" +
+	"\x03pos\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x03pos\x120\n" +
+	"\x04name\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\x04name\x12-\n" +
+	"\x03age\x18\x06 \x01(\v2\x1b.google.protobuf.Int64ValueR\x03age\x12)\n" +
+	"\x01g\x18\a \x01(\v2\x1b.google.protobuf.Int64ValueR\x01g\x12+\n" +
+	"\x02pa\x18\b \x01(\v2\x1b.google.protobuf.Int64ValueR\x02pa\x12+\n" +
+	"\x02ab\x18\t \x01(\v2\x1b.google.protobuf.Int64ValueR\x02ab\x12)\n" +
+	"\x01r\x18\n" +
+	" \x01(\v2\x1b.google.protobuf.Int64ValueR\x01r\x12)\n" +
+	"\x01h\x18\v \x01(\v2\x1b.google.protobuf.Int64ValueR\x01h\x12/\n" +
+	"\x04twob\x18\f \x01(\v2\x1b.google.protobuf.Int64ValueR\x04twob\x123\n" +
+	"\x06threeb\x18\r \x01(\v2\x1b.google.protobuf.Int64ValueR\x06threeb\x12+\n" +
+	"\x02hr\x18\x0e \x01(\v2\x1b.google.protobuf.Int64ValueR\x02hr\x12-\n" +
+	"\x03rbi\x18\x0f \x01(\v2\x1b.google.protobuf.Int64ValueR\x03rbi\x12+\n" +
+	"\x02sb\x18\x10 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02sb\x12+\n" +
+	"\x02cs\x18\x11 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02cs\x12+\n" +
+	"\x02bb\x18\x12 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02bb\x12+\n" +
+	"\x02so\x18\x13 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02so\x12,\n" +
+	"\x02ba\x18\x14 \x01(\v2\x1c.google.protobuf.DoubleValueR\x02ba\x12.\n" +
+	"\x03obp\x18\x15 \x01(\v2\x1c.google.protobuf.DoubleValueR\x03obp\x12.\n" +
+	"\x03slg\x18\x16 \x01(\v2\x1c.google.protobuf.DoubleValueR\x03slg\x12.\n" +
+	"\x03ops\x18\x17 \x01(\v2\x1c.google.protobuf.DoubleValueR\x03ops\x125\n" +
+	"\aopsplus\x18\x18 \x01(\v2\x1b.google.protobuf.Int64ValueR\aopsplus\x12+\n" +
+	"\x02tb\x18\x19 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02tb\x12-\n" +
+	"\x03gdp\x18\x1a \x01(\v2\x1b.google.protobuf.Int64ValueR\x03gdp\x12-\n" +
+	"\x03hbp\x18\x1b \x01(\v2\x1b.google.protobuf.Int64ValueR\x03hbp\x12+\n" +
+	"\x02sh\x18\x1c \x01(\v2\x1b.google.protobuf.Int64ValueR\x02sh\x12+\n" +
+	"\x02sf\x18\x1d \x01(\v2\x1b.google.protobuf.Int64ValueR\x02sf\x12-\n" +
+	"\x03ibb\x18\x1e \x01(\v2\x1b.google.protobuf.Int64ValueR\x03ibb\x12<\n" +
+	"\vcreateddate\x18\x1f \x01(\v2\x1a.google.protobuf.TimestampR\vcreateddate\"\xfe\f\n" +
+	"\aPitcher\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1e\n" +
+	"\n" +
+	"teamabbrev\x18\x02 \x01(\tR\n" +
+	"teamabbrev\x12\x0e\n" +
+	"\x02rk\x18\x03 \x01(\x03R\x02rk\x12.\n" +
+	"\x03pos\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x03pos\x120\n" +
+	"\x04name\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\x04name\x12-\n" +
+	"\x03age\x18\x06 \x01(\v2\x1b.google.protobuf.Int64ValueR\x03age\x12)\n" +
+	"\x01w\x18\a \x01(\v2\x1b.google.protobuf.Int64ValueR\x01w\x12)\n" +
+	"\x01l\x18\b \x01(\v2\x1b.google.protobuf.Int64ValueR\x01l\x12,\n" +
+	"\x02wl\x18\t \x01(\v2\x1c.google.protobuf.DoubleValueR\x02wl\x12.\n" +
+	"\x03era\x18\n" +
+	" \x01(\v2\x1c.google.protobuf.DoubleValueR\x03era\x12)\n" +
+	"\x01g\x18\v \x01(\v2\x1b.google.protobuf.Int64ValueR\x01g\x12+\n" +
+	"\x02gs\x18\f \x01(\v2\x1b.google.protobuf.Int64ValueR\x02gs\x12+\n" +
+	"\x02gf\x18\r \x01(\v2\x1b.google.protobuf.Int64ValueR\x02gf\x12+\n" +
+	"\x02cg\x18\x0e \x01(\v2\x1b.google.protobuf.Int64ValueR\x02cg\x12-\n" +
+	"\x03sho\x18\x0f \x01(\v2\x1b.google.protobuf.Int64ValueR\x03sho\x12+\n" +
+	"\x02sv\x18\x10 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02sv\x12,\n" +
+	"\x02ip\x18\x11 \x01(\v2\x1c.google.protobuf.DoubleValueR\x02ip\x12)\n" +
+	"\x01h\x18\x12 \x01(\v2\x1b.google.protobuf.Int64ValueR\x01h\x12)\n" +
+	"\x01r\x18\x13 \x01(\v2\x1b.google.protobuf.Int64ValueR\x01r\x12+\n" +
+	"\x02er\x18\x14 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02er\x12+\n" +
+	"\x02hr\x18\x15 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02hr\x12+\n" +
+	"\x02bb\x18\x16 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02bb\x12-\n" +
+	"\x03ibb\x18\x17 \x01(\v2\x1b.google.protobuf.Int64ValueR\x03ibb\x12+\n" +
+	"\x02so\x18\x18 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02so\x12-\n" +
+	"\x03hbp\x18\x19 \x01(\v2\x1b.google.protobuf.Int64ValueR\x03hbp\x12+\n" +
+	"\x02bk\x18\x1a \x01(\v2\x1b.google.protobuf.Int64ValueR\x02bk\x12+\n" +
+	"\x02wp\x18\x1b \x01(\v2\x1b.google.protobuf.Int64ValueR\x02wp\x12+\n" +
+	"\x02bf\x18\x1c \x01(\v2\x1b.google.protobuf.Int64ValueR\x02bf\x125\n" +
+	"\aeraplus\x18\x1d \x01(\v2\x1b.google.protobuf.Int64ValueR\aeraplus\x12.\n" +
+	"\x03fip\x18\x1e \x01(\v2\x1c.google.protobuf.DoubleValueR\x03fip\x120\n" +
+	"\x04whip\x18\x1f \x01(\v2\x1c.google.protobuf.DoubleValueR\x04whip\x12,\n" +
+	"\x02h9\x18  \x01(\v2\x1c.google.protobuf.DoubleValueR\x02h9\x12.\n" +
+	"\x03hr9\x18! \x01(\v2\x1c.google.protobuf.DoubleValueR\x03hr9\x12.\n" +
+	"\x03bb9\x18\" \x01(\v2\x1c.google.protobuf.DoubleValueR\x03bb9\x12.\n" +
+	"\x03so9\x18# \x01(\v2\x1c.google.protobuf.DoubleValueR\x03so9\x12.\n" +
+	"\x03sow\x18$ \x01(\v2\x1c.google.protobuf.DoubleValueR\x03sow\x12<\n" +
+	"\vcreateddate\x18% \x01(\v2\x1a.google.protobuf.TimestampR\vcreateddate\"\xd5\v\n" +
+	"\fBattingSplit\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1e\n" +
+	"\n" +
+	"teamabbrev\x18\x02 \x01(\tR\n" +
+	"teamabbrev\x122\n" +
+	"\x05split\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\x05split\x12)\n" +
+	"\x01g\x18\x04 \x01(\v2\x1b.google.protobuf.Int64ValueR\x01g\x12+\n" +
+	"\x02gs\x18\x05 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02gs\x12+\n" +
+	"\x02pa\x18\x06 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02pa\x12+\n" +
+	"\x02ab\x18\a \x01(\v2\x1b.google.protobuf.Int64ValueR\x02ab\x12)\n" +
+	"\x01r\x18\b \x01(\v2\x1b.google.protobuf.Int64ValueR\x01r\x12)\n" +
+	"\x01h\x18\t \x01(\v2\x1b.google.protobuf.Int64ValueR\x01h\x12/\n" +
+	"\x04twob\x18\n" +
+	" \x01(\v2\x1b.google.protobuf.Int64ValueR\x04twob\x123\n" +
+	"\x06threeb\x18\v \x01(\v2\x1b.google.protobuf.Int64ValueR\x06threeb\x12+\n" +
+	"\x02hr\x18\f \x01(\v2\x1b.google.protobuf.Int64ValueR\x02hr\x12-\n" +
+	"\x03rbi\x18\r \x01(\v2\x1b.google.protobuf.Int64ValueR\x03rbi\x12+\n" +
+	"\x02sb\x18\x0e \x01(\v2\x1b.google.protobuf.Int64ValueR\x02sb\x12+\n" +
+	"\x02cs\x18\x0f \x01(\v2\x1b.google.protobuf.Int64ValueR\x02cs\x12+\n" +
+	"\x02bb\x18\x10 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02bb\x12+\n" +
+	"\x02so\x18\x11 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02so\x12,\n" +
+	"\x02ba\x18\x12 \x01(\v2\x1c.google.protobuf.DoubleValueR\x02ba\x12.\n" +
+	"\x03obp\x18\x13 \x01(\v2\x1c.google.protobuf.DoubleValueR\x03obp\x12.\n" +
+	"\x03slg\x18\x14 \x01(\v2\x1c.google.protobuf.DoubleValueR\x03slg\x12.\n" +
+	"\x03ops\x18\x15 \x01(\v2\x1c.google.protobuf.DoubleValueR\x03ops\x12+\n" +
+	"\x02tb\x18\x16 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02tb\x12-\n" +
+	"\x03gdp\x18\x17 \x01(\v2\x1b.google.protobuf.Int64ValueR\x03gdp\x12-\n" +
+	"\x03hbp\x18\x18 \x01(\v2\x1b.google.protobuf.Int64ValueR\x03hbp\x12+\n" +
+	"\x02sh\x18\x19 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02sh\x12+\n" +
+	"\x02sf\x18\x1a \x01(\v2\x1b.google.protobuf.Int64ValueR\x02sf\x12-\n" +
+	"\x03ibb\x18\x1b \x01(\v2\x1b.google.protobuf.Int64ValueR\x03ibb\x12-\n" +
+	"\x03roe\x18\x1c \x01(\v2\x1b.google.protobuf.Int64ValueR\x03roe\x122\n" +
+	"\x05babip\x18\x1d \x01(\v2\x1c.google.protobuf.DoubleValueR\x05babip\x127\n" +
+	"\btopsplus\x18\x1e \x01(\v2\x1b.google.protobuf.Int64ValueR\btopsplus\x127\n" +
+	"\bsopsplus\x18\x1f \x01(\v2\x1b.google.protobuf.Int64ValueR\bsopsplus\x12<\n" +
+	"\vcreateddate\x18  \x01(\v2\x1a.google.protobuf.TimestampR\vcreateddate\"\xaa\v\n" +
+	"\rPitchingSplit\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1e\n" +
+	"\n" +
+	"teamabbrev\x18\x02 \x01(\tR\n" +
+	"teamabbrev\x122\n" +
+	"\x05split\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\x05split\x12)\n" +
+	"\x01g\x18\x04 \x01(\v2\x1b.google.protobuf.Int64ValueR\x01g\x12+\n" +
+	"\x02pa\x18\x05 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02pa\x12+\n" +
+	"\x02ab\x18\x06 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02ab\x12)\n" +
+	"\x01r\x18\a \x01(\v2\x1b.google.protobuf.Int64ValueR\x01r\x12)\n" +
+	"\x01h\x18\b \x01(\v2\x1b.google.protobuf.Int64ValueR\x01h\x12/\n" +
+	"\x04twob\x18\t \x01(\v2\x1b.google.protobuf.Int64ValueR\x04twob\x123\n" +
+	"\x06threeb\x18\n" +
+	" \x01(\v2\x1b.google.protobuf.Int64ValueR\x06threeb\x12+\n" +
+	"\x02hr\x18\v \x01(\v2\x1b.google.protobuf.Int64ValueR\x02hr\x12+\n" +
+	"\x02sb\x18\f \x01(\v2\x1b.google.protobuf.Int64ValueR\x02sb\x12+\n" +
+	"\x02cs\x18\r \x01(\v2\x1b.google.protobuf.Int64ValueR\x02cs\x12+\n" +
+	"\x02bb\x18\x0e \x01(\v2\x1b.google.protobuf.Int64ValueR\x02bb\x12+\n" +
+	"\x02so\x18\x0f \x01(\v2\x1b.google.protobuf.Int64ValueR\x02so\x12.\n" +
+	"\x03sow\x18\x10 \x01(\v2\x1c.google.protobuf.DoubleValueR\x03sow\x12,\n" +
+	"\x02ba\x18\x11 \x01(\v2\x1c.google.protobuf.DoubleValueR\x02ba\x12.\n" +
+	"\x03obp\x18\x12 \x01(\v2\x1c.google.protobuf.DoubleValueR\x03obp\x12.\n" +
+	"\x03slg\x18\x13 \x01(\v2\x1c.google.protobuf.DoubleValueR\x03slg\x12.\n" +
+	"\x03ops\x18\x14 \x01(\v2\x1c.google.protobuf.DoubleValueR\x03ops\x12+\n" +
+	"\x02tb\x18\x15 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02tb\x12-\n" +
+	"\x03gdp\x18\x16 \x01(\v2\x1b.google.protobuf.Int64ValueR\x03gdp\x12-\n" +
+	"\x03hbp\x18\x17 \x01(\v2\x1b.google.protobuf.Int64ValueR\x03hbp\x12+\n" +
+	"\x02sh\x18\x18 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02sh\x12+\n" +
+	"\x02sf\x18\x19 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02sf\x12-\n" +
+	"\x03ibb\x18\x1a \x01(\v2\x1b.google.protobuf.Int64ValueR\x03ibb\x12-\n" +
+	"\x03roe\x18\x1b \x01(\v2\x1b.google.protobuf.Int64ValueR\x03roe\x122\n" +
+	"\x05babip\x18\x1c \x01(\v2\x1c.google.protobuf.DoubleValueR\x05babip\x127\n" +
+	"\btopsplus\x18\x1d \x01(\v2\x1b.google.protobuf.Int64ValueR\btopsplus\x127\n" +
+	"\bsopsplus\x18\x1e \x01(\v2\x1b.google.protobuf.Int64ValueR\bsopsplus\x12<\n" +
+	"\vcreateddate\x18\x1f \x01(\v2\x1a.google.protobuf.TimestampR\vcreateddate\"\x92\x0e\n" +
+	"\n" +
+	"Baserunner\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1e\n" +
+	"\n" +
+	"teamabbrev\x18\x02 \x01(\tR\n" +
+	"teamabbrev\x120\n" +
+	"\x04name\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\x04name\x12-\n" +
+	"\x03age\x18\x04 \x01(\v2\x1b.google.protobuf.Int64ValueR\x03age\x12+\n" +
+	"\x02pa\x18\x05 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02pa\x12-\n" +
+	"\x03roe\x18\x06 \x01(\v2\x1b.google.protobuf.Int64ValueR\x03roe\x12+\n" +
+	"\x02xi\x18\a \x01(\v2\x1b.google.protobuf.Int64ValueR\x02xi\x122\n" +
+	"\x05rspct\x18\b \x01(\v2\x1c.google.protobuf.StringValueR\x05rspct\x12-\n" +
+	"\x03sbo\x18\t \x01(\v2\x1b.google.protobuf.Int64ValueR\x03sbo\x12+\n" +
+	"\x02sb\x18\n" +
+	" \x01(\v2\x1b.google.protobuf.Int64ValueR\x02sb\x12+\n" +
+	"\x02cs\x18\v \x01(\v2\x1b.google.protobuf.Int64ValueR\x02cs\x122\n" +
+	"\x05sbpct\x18\f \x01(\v2\x1c.google.protobuf.StringValueR\x05sbpct\x12-\n" +
+	"\x03sb2\x18\r \x01(\v2\x1b.google.protobuf.Int64ValueR\x03sb2\x12-\n" +
+	"\x03cs2\x18\x0e \x01(\v2\x1b.google.protobuf.Int64ValueR\x03cs2\x12-\n" +
+	"\x03sb3\x18\x0f \x01(\v2\x1b.google.protobuf.Int64ValueR\x03sb3\x12-\n" +
+	"\x03cs3\x18\x10 \x01(\v2\x1b.google.protobuf.Int64ValueR\x03cs3\x12-\n" +
+	"\x03sbh\x18\x11 \x01(\v2\x1b.google.protobuf.Int64ValueR\x03sbh\x12-\n" +
+	"\x03csh\x18\x12 \x01(\v2\x1b.google.protobuf.Int64ValueR\x03csh\x12+\n" +
+	"\x02po\x18\x13 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02po\x12-\n" +
+	"\x03pcs\x18\x14 \x01(\v2\x1b.google.protobuf.Int64ValueR\x03pcs\x12-\n" +
+	"\x03oob\x18\x15 \x01(\v2\x1b.google.protobuf.Int64ValueR\x03oob\x12/\n" +
+	"\x04oob1\x18\x16 \x01(\v2\x1b.google.protobuf.Int64ValueR\x04oob1\x12/\n" +
+	"\x04oob2\x18\x17 \x01(\v2\x1b.google.protobuf.Int64ValueR\x04oob2\x12/\n" +
+	"\x04oob3\x18\x18 \x01(\v2\x1b.google.protobuf.Int64ValueR\x04oob3\x121\n" +
+	"\x05oobhm\x18\x19 \x01(\v2\x1b.google.protobuf.Int64ValueR\x05oobhm\x12+\n" +
+	"\x02bt\x18\x1a \x01(\v2\x1b.google.protobuf.Int64ValueR\x02bt\x124\n" +
+	"\x06xbtpct\x18\x1b \x01(\v2\x1c.google.protobuf.StringValueR\x06xbtpct\x123\n" +
+	"\x06firsts\x18\x1c \x01(\v2\x1b.google.protobuf.Int64ValueR\x06firsts\x125\n" +
+	"\afirsts2\x18\x1d \x01(\v2\x1b.google.protobuf.Int64ValueR\afirsts2\x125\n" +
+	"\afirsts3\x18\x1e \x01(\v2\x1b.google.protobuf.Int64ValueR\afirsts3\x123\n" +
+	"\x06firstd\x18\x1f \x01(\v2\x1b.google.protobuf.Int64ValueR\x06firstd\x125\n" +
+	"\afirstd3\x18  \x01(\v2\x1b.google.protobuf.Int64ValueR\afirstd3\x125\n" +
+	"\afirstdh\x18! \x01(\v2\x1b.google.protobuf.Int64ValueR\afirstdh\x125\n" +
+	"\aseconds\x18\" \x01(\v2\x1b.google.protobuf.Int64ValueR\aseconds\x127\n" +
+	"\bseconds3\x18# \x01(\v2\x1b.google.protobuf.Int64ValueR\bseconds3\x127\n" +
+	"\bsecondsh\x18$ \x01(\v2\x1b.google.protobuf.Int64ValueR\bsecondsh\x12<\n" +
+	"\vcreateddate\x18% \x01(\v2\x1a.google.protobuf.TimestampR\vcreateddate\"\xf5\n" +
+	"\n" +
+	"\x0fBattingPitching\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1e\n" +
+	"\n" +
+	"teamabbrev\x18\x02 \x01(\tR\n" +
+	"teamabbrev\x12\x0e\n" +
+	"\x02rk\x18\x03 \x01(\x03R\x02rk\x120\n" +
+	"\x04name\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x04name\x12-\n" +
+	"\x03age\x18\x05 \x01(\v2\x1b.google.protobuf.Int64ValueR\x03age\x12,\n" +
+	"\x02ip\x18\x06 \x01(\v2\x1c.google.protobuf.DoubleValueR\x02ip\x12)\n" +
+	"\x01g\x18\a \x01(\v2\x1b.google.protobuf.Int64ValueR\x01g\x12+\n" +
+	"\x02pa\x18\b \x01(\v2\x1b.google.protobuf.Int64ValueR\x02pa\x12+\n" +
+	"\x02ab\x18\t \x01(\v2\x1b.google.protobuf.Int64ValueR\x02ab\x12)\n" +
+	"\x01r\x18\n" +
+	" \x01(\v2\x1b.google.protobuf.Int64ValueR\x01r\x12)\n" +
+	"\x01h\x18\v \x01(\v2\x1b.google.protobuf.Int64ValueR\x01h\x12/\n" +
+	"\x04twob\x18\f \x01(\v2\x1b.google.protobuf.Int64ValueR\x04twob\x123\n" +
+	"\x06threeb\x18\r \x01(\v2\x1b.google.protobuf.Int64ValueR\x06threeb\x12+\n" +
+	"\x02hr\x18\x0e \x01(\v2\x1b.google.protobuf.Int64ValueR\x02hr\x12+\n" +
+	"\x02sb\x18\x0f \x01(\v2\x1b.google.protobuf.Int64ValueR\x02sb\x12+\n" +
+	"\x02cs\x18\x10 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02cs\x12+\n" +
+	"\x02bb\x18\x11 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02bb\x12+\n" +
+	"\x02so\x18\x12 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02so\x12,\n" +
+	"\x02ba\x18\x13 \x01(\v2\x1c.google.protobuf.DoubleValueR\x02ba\x12.\n" +
+	"\x03obp\x18\x14 \x01(\v2\x1c.google.protobuf.DoubleValueR\x03obp\x12.\n" +
+	"\x03slg\x18\x15 \x01(\v2\x1c.google.protobuf.DoubleValueR\x03slg\x12.\n" +
+	"\x03ops\x18\x16 \x01(\v2\x1c.google.protobuf.DoubleValueR\x03ops\x12+\n" +
+	"\x02tb\x18\x17 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02tb\x12-\n" +
+	"\x03gdp\x18\x18 \x01(\v2\x1b.google.protobuf.Int64ValueR\x03gdp\x12-\n" +
+	"\x03hbp\x18\x19 \x01(\v2\x1b.google.protobuf.Int64ValueR\x03hbp\x12+\n" +
+	"\x02sh\x18\x1a \x01(\v2\x1b.google.protobuf.Int64ValueR\x02sh\x12+\n" +
+	"\x02sf\x18\x1b \x01(\v2\x1b.google.protobuf.Int64ValueR\x02sf\x12-\n" +
+	"\x03ibb\x18\x1c \x01(\v2\x1b.google.protobuf.Int64ValueR\x03ibb\x12-\n" +
+	"\x03roe\x18\x1d \x01(\v2\x1b.google.protobuf.Int64ValueR\x03roe\x122\n" +
+	"\x05babip\x18\x1e \x01(\v2\x1c.google.protobuf.DoubleValueR\x05babip\x12<\n" +
+	"\vcreateddate\x18\x1f \x01(\v2\x1a.google.protobuf.TimestampR\vcreateddate\"\xa2\x01\n" +
+	"\bSnapshot\x12\x1c\n" +
+	"\ttablename\x18\x01 \x01(\tR\ttablename\x12\x1e\n" +
+	"\n" +
+	"teamabbrev\x18\x02 \x01(\tR\n" +
+	"teamabbrev\x12<\n" +
+	"\vcreateddate\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vcreateddate\x12\x1a\n" +
+	"\browcount\x18\x04 \x01(\x03R\browcount\"\xef\t\n" +
+	"\fBattingDelta\x120\n" +
+	"\x04name\x18\x01 \x01(\v2\x1c.google.protobuf.StringValueR\x04name\x12\x1e\n" +
+	"\n" +
+	"teamabbrev\x18\x02 \x01(\tR\n" +
+	"teamabbrev\x126\n" +
+	"\bfromdate\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bfromdate\x122\n" +
+	"\x06todate\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06todate\x12)\n" +
+	"\x01g\x18\x05 \x01(\v2\x1b.google.protobuf.Int64ValueR\x01g\x12+\n" +
+	"\x02pa\x18\x06 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02pa\x12+\n" +
+	"\x02ab\x18\a \x01(\v2\x1b.google.protobuf.Int64ValueR\x02ab\x12)\n" +
+	"\x01r\x18\b \x01(\v2\x1b.google.protobuf.Int64ValueR\x01r\x12)\n" +
+	"\x01h\x18\t \x01(\v2\x1b.google.protobuf.Int64ValueR\x01h\x12/\n" +
+	"\x04twob\x18\n" +
+	" \x01(\v2\x1b.google.protobuf.Int64ValueR\x04twob\x123\n" +
+	"\x06threeb\x18\v \x01(\v2\x1b.google.protobuf.Int64ValueR\x06threeb\x12+\n" +
+	"\x02hr\x18\f \x01(\v2\x1b.google.protobuf.Int64ValueR\x02hr\x12-\n" +
+	"\x03rbi\x18\r \x01(\v2\x1b.google.protobuf.Int64ValueR\x03rbi\x12+\n" +
+	"\x02sb\x18\x0e \x01(\v2\x1b.google.protobuf.Int64ValueR\x02sb\x12+\n" +
+	"\x02cs\x18\x0f \x01(\v2\x1b.google.protobuf.Int64ValueR\x02cs\x12+\n" +
+	"\x02bb\x18\x10 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02bb\x12+\n" +
+	"\x02so\x18\x11 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02so\x12+\n" +
+	"\x02tb\x18\x12 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02tb\x12-\n" +
+	"\x03gdp\x18\x13 \x01(\v2\x1b.google.protobuf.Int64ValueR\x03gdp\x12-\n" +
+	"\x03hbp\x18\x14 \x01(\v2\x1b.google.protobuf.Int64ValueR\x03hbp\x12+\n" +
+	"\x02sh\x18\x15 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02sh\x12+\n" +
+	"\x02sf\x18\x16 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02sf\x12-\n" +
+	"\x03ibb\x18\x17 \x01(\v2\x1b.google.protobuf.Int64ValueR\x03ibb\x12,\n" +
+	"\x02ba\x18\x18 \x01(\v2\x1c.google.protobuf.DoubleValueR\x02ba\x12.\n" +
+	"\x03obp\x18\x19 \x01(\v2\x1c.google.protobuf.DoubleValueR\x03obp\x12.\n" +
+	"\x03slg\x18\x1a \x01(\v2\x1c.google.protobuf.DoubleValueR\x03slg\x12.\n" +
+	"\x03ops\x18\x1b \x01(\v2\x1c.google.protobuf.DoubleValueR\x03ops\"\xcc\v\n" +
+	"\rPitchingDelta\x120\n" +
+	"\x04name\x18\x01 \x01(\v2\x1c.google.protobuf.StringValueR\x04name\x12\x1e\n" +
+	"\n" +
+	"teamabbrev\x18\x02 \x01(\tR\n" +
+	"teamabbrev\x126\n" +
+	"\bfromdate\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bfromdate\x122\n" +
+	"\x06todate\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06todate\x12)\n" +
+	"\x01w\x18\x05 \x01(\v2\x1b.google.protobuf.Int64ValueR\x01w\x12)\n" +
+	"\x01l\x18\x06 \x01(\v2\x1b.google.protobuf.Int64ValueR\x01l\x12)\n" +
+	"\x01g\x18\a \x01(\v2\x1b.google.protobuf.Int64ValueR\x01g\x12+\n" +
+	"\x02gs\x18\b \x01(\v2\x1b.google.protobuf.Int64ValueR\x02gs\x12+\n" +
+	"\x02gf\x18\t \x01(\v2\x1b.google.protobuf.Int64ValueR\x02gf\x12+\n" +
+	"\x02cg\x18\n" +
+	" \x01(\v2\x1b.google.protobuf.Int64ValueR\x02cg\x12-\n" +
+	"\x03sho\x18\v \x01(\v2\x1b.google.protobuf.Int64ValueR\x03sho\x12+\n" +
+	"\x02sv\x18\f \x01(\v2\x1b.google.protobuf.Int64ValueR\x02sv\x12,\n" +
+	"\x02ip\x18\r \x01(\v2\x1c.google.protobuf.DoubleValueR\x02ip\x12)\n" +
+	"\x01h\x18\x0e \x01(\v2\x1b.google.protobuf.Int64ValueR\x01h\x12)\n" +
+	"\x01r\x18\x0f \x01(\v2\x1b.google.protobuf.Int64ValueR\x01r\x12+\n" +
+	"\x02er\x18\x10 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02er\x12+\n" +
+	"\x02hr\x18\x11 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02hr\x12+\n" +
+	"\x02bb\x18\x12 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02bb\x12-\n" +
+	"\x03ibb\x18\x13 \x01(\v2\x1b.google.protobuf.Int64ValueR\x03ibb\x12+\n" +
+	"\x02so\x18\x14 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02so\x12-\n" +
+	"\x03hbp\x18\x15 \x01(\v2\x1b.google.protobuf.Int64ValueR\x03hbp\x12+\n" +
+	"\x02bk\x18\x16 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02bk\x12+\n" +
+	"\x02wp\x18\x17 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02wp\x12+\n" +
+	"\x02bf\x18\x18 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02bf\x12,\n" +
+	"\x02wl\x18\x19 \x01(\v2\x1c.google.protobuf.DoubleValueR\x02wl\x12.\n" +
+	"\x03era\x18\x1a \x01(\v2\x1c.google.protobuf.DoubleValueR\x03era\x120\n" +
+	"\x04whip\x18\x1b \x01(\v2\x1c.google.protobuf.DoubleValueR\x04whip\x12,\n" +
+	"\x02h9\x18\x1c \x01(\v2\x1c.google.protobuf.DoubleValueR\x02h9\x12.\n" +
+	"\x03hr9\x18\x1d \x01(\v2\x1c.google.protobuf.DoubleValueR\x03hr9\x12.\n" +
+	"\x03bb9\x18\x1e \x01(\v2\x1c.google.protobuf.DoubleValueR\x03bb9\x12.\n" +
+	"\x03so9\x18\x1f \x01(\v2\x1c.google.protobuf.DoubleValueR\x03so9\x12.\n" +
+	"\x03sow\x18  \x01(\v2\x1c.google.protobuf.DoubleValueR\x03sow\"\x9c\r\n" +
+	"\x0fBaserunnerDelta\x120\n" +
+	"\x04name\x18\x01 \x01(\v2\x1c.google.protobuf.StringValueR\x04name\x12\x1e\n" +
+	"\n" +
+	"teamabbrev\x18\x02 \x01(\tR\n" +
+	"teamabbrev\x126\n" +
+	"\bfromdate\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bfromdate\x122\n" +
+	"\x06todate\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06todate\x12+\n" +
+	"\x02pa\x18\x05 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02pa\x12-\n" +
+	"\x03roe\x18\x06 \x01(\v2\x1b.google.protobuf.Int64ValueR\x03roe\x12+\n" +
+	"\x02xi\x18\a \x01(\v2\x1b.google.protobuf.Int64ValueR\x02xi\x12-\n" +
+	"\x03sbo\x18\b \x01(\v2\x1b.google.protobuf.Int64ValueR\x03sbo\x12+\n" +
+	"\x02sb\x18\t \x01(\v2\x1b.google.protobuf.Int64ValueR\x02sb\x12+\n" +
+	"\x02cs\x18\n" +
+	" \x01(\v2\x1b.google.protobuf.Int64ValueR\x02cs\x12-\n" +
+	"\x03sb2\x18\v \x01(\v2\x1b.google.protobuf.Int64ValueR\x03sb2\x12-\n" +
+	"\x03cs2\x18\f \x01(\v2\x1b.google.protobuf.Int64ValueR\x03cs2\x12-\n" +
+	"\x03sb3\x18\r \x01(\v2\x1b.google.protobuf.Int64ValueR\x03sb3\x12-\n" +
+	"\x03cs3\x18\x0e \x01(\v2\x1b.google.protobuf.Int64ValueR\x03cs3\x12-\n" +
+	"\x03sbh\x18\x0f \x01(\v2\x1b.google.protobuf.Int64ValueR\x03sbh\x12-\n" +
+	"\x03csh\x18\x10 \x01(\v2\x1b.google.protobuf.Int64ValueR\x03csh\x12+\n" +
+	"\x02po\x18\x11 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02po\x12-\n" +
+	"\x03pcs\x18\x12 \x01(\v2\x1b.google.protobuf.Int64ValueR\x03pcs\x12-\n" +
+	"\x03oob\x18\x13 \x01(\v2\x1b.google.protobuf.Int64ValueR\x03oob\x12/\n" +
+	"\x04oob1\x18\x14 \x01(\v2\x1b.google.protobuf.Int64ValueR\x04oob1\x12/\n" +
+	"\x04oob2\x18\x15 \x01(\v2\x1b.google.protobuf.Int64ValueR\x04oob2\x12/\n" +
+	"\x04oob3\x18\x16 \x01(\v2\x1b.google.protobuf.Int64ValueR\x04oob3\x121\n" +
+	"\x05oobhm\x18\x17 \x01(\v2\x1b.google.protobuf.Int64ValueR\x05oobhm\x12+\n" +
+	"\x02bt\x18\x18 \x01(\v2\x1b.google.protobuf.Int64ValueR\x02bt\x123\n" +
+	"\x06firsts\x18\x19 \x01(\v2\x1b.google.protobuf.Int64ValueR\x06firsts\x125\n" +
+	"\afirsts2\x18\x1a \x01(\v2\x1b.google.protobuf.Int64ValueR\afirsts2\x125\n" +
+	"\afirsts3\x18\x1b \x01(\v2\x1b.google.protobuf.Int64ValueR\afirsts3\x123\n" +
+	"\x06firstd\x18\x1c \x01(\v2\x1b.google.protobuf.Int64ValueR\x06firstd\x125\n" +
+	"\afirstd3\x18\x1d \x01(\v2\x1b.google.protobuf.Int64ValueR\afirstd3\x125\n" +
+	"\afirstdh\x18\x1e \x01(\v2\x1b.google.protobuf.Int64ValueR\afirstdh\x125\n" +
+	"\aseconds\x18\x1f \x01(\v2\x1b.google.protobuf.Int64ValueR\aseconds\x127\n" +
+	"\bseconds3\x18  \x01(\v2\x1b.google.protobuf.Int64ValueR\bseconds3\x127\n" +
+	"\bsecondsh\x18! \x01(\v2\x1b.google.protobuf.Int64ValueR\bsecondsh\x122\n" +
+	"\x05sbpct\x18\" \x01(\v2\x1c.google.protobuf.DoubleValueR\x05sbpct\"^\n" +
+	"\rBattingLeader\x12\x1e\n" +
+	"\n" +
+	"leaguerank\x18\x01 \x01(\x03R\n" +
+	"leaguerank\x12-\n" +
+	"\x06batter\x18\x02 \x01(\v2\x15.sportsdata.v1.BatterR\x06batter\"b\n" +
+	"\x0ePitchingLeader\x12\x1e\n" +
+	"\n" +
+	"leaguerank\x18\x01 \x01(\x03R\n" +
+	"leaguerank\x120\n" +
+	"\apitcher\x18\x02 \x01(\v2\x16.sportsdata.v1.PitcherR\apitcher2\x8d\x10\n" +
+	"\n" +
+	"SportsData\x12N\n" +
+	"\tListTeams\x12\x1f.sportsdata.v1.ListTeamsRequest\x1a .sportsdata.v1.ListTeamsResponse\x12M\n" +
+	"\vListBatting\x12\x1a.sportsdata.v1.StatRequest\x1a\".sportsdata.v1.ListBattingResponse\x12D\n" +
+	"\rStreamBatting\x12\x1a.sportsdata.v1.StatRequest\x1a\x15.sportsdata.v1.Batter0\x01\x12O\n" +
+	"\fListPitching\x12\x1a.sportsdata.v1.StatRequest\x1a#.sportsdata.v1.ListPitchingResponse\x12F\n" +
+	"\x0eStreamPitching\x12\x1a.sportsdata.v1.StatRequest\x1a\x16.sportsdata.v1.Pitcher0\x01\x12Y\n" +
+	"\x11ListBattingSplits\x12\x1a.sportsdata.v1.StatRequest\x1a(.sportsdata.v1.ListBattingSplitsResponse\x12P\n" +
+	"\x13StreamBattingSplits\x12\x1a.sportsdata.v1.StatRequest\x1a\x1b.sportsdata.v1.BattingSplit0\x01\x12[\n" +
+	"\x12ListPitchingSplits\x12\x1a.sportsdata.v1.StatRequest\x1a).sportsdata.v1.ListPitchingSplitsResponse\x12R\n" +
+	"\x14StreamPitchingSplits\x12\x1a.sportsdata.v1.StatRequest\x1a\x1c.sportsdata.v1.PitchingSplit0\x01\x12U\n" +
+	"\x0fListBaserunning\x12\x1a.sportsdata.v1.StatRequest\x1a&.sportsdata.v1.ListBaserunningResponse\x12L\n" +
+	"\x11StreamBaserunning\x12\x1a.sportsdata.v1.StatRequest\x1a\x19.sportsdata.v1.Baserunner0\x01\x12]\n" +
+	"\x13ListBattingPitching\x12\x1a.sportsdata.v1.StatRequest\x1a*.sportsdata.v1.ListBattingPitchingResponse\x12U\n" +
+	"\x15StreamBattingPitching\x12\x1a.sportsdata.v1.StatRequest\x1a\x1e.sportsdata.v1.BattingPitching0\x01\x12[\n" +
+	"\x13ListBattingHomeAway\x12\x1a.sportsdata.v1.StatRequest\x1a(.sportsdata.v1.ListBattingSplitsResponse\x12R\n" +
+	"\x15StreamBattingHomeAway\x12\x1a.sportsdata.v1.StatRequest\x1a\x1b.sportsdata.v1.BattingSplit0\x01\x12]\n" +
+	"\x14ListPitchingHomeAway\x12\x1a.sportsdata.v1.StatRequest\x1a).sportsdata.v1.ListPitchingSplitsResponse\x12T\n" +
+	"\x16StreamPitchingHomeAway\x12\x1a.sportsdata.v1.StatRequest\x1a\x1c.sportsdata.v1.PitchingSplit0\x01\x12Z\n" +
+	"\rListSnapshots\x12#.sportsdata.v1.ListSnapshotsRequest\x1a$.sportsdata.v1.ListSnapshotsResponse\x12]\n" +
+	"\x10GetPlayerHistory\x12#.sportsdata.v1.PlayerHistoryRequest\x1a$.sportsdata.v1.PlayerHistoryResponse\x12M\n" +
+	"\vDiffBatting\x12\x1a.sportsdata.v1.DiffRequest\x1a\".sportsdata.v1.DiffBattingResponse\x12O\n" +
+	"\fDiffPitching\x12\x1a.sportsdata.v1.DiffRequest\x1a#.sportsdata.v1.DiffPitchingResponse\x12U\n" +
+	"\x0fDiffBaserunning\x12\x1a.sportsdata.v1.DiffRequest\x1a&.sportsdata.v1.DiffBaserunningResponse\x12V\n" +
+	"\x0eBattingLeaders\x12\x1d.sportsdata.v1.LeadersRequest\x1a%.sportsdata.v1.BattingLeadersResponse\x12X\n" +
+	"\x0fPitchingLeaders\x12\x1d.sportsdata.v1.LeadersRequest\x1a&.sportsdata.v1.PitchingLeadersResponseB\x15Z\x13sports-data-api/rpcb\x06proto3"
+
+var (
+	file_rpc_sportsdata_proto_rawDescOnce sync.Once
+	file_rpc_sportsdata_proto_rawDescData []byte
+)
+
+func file_rpc_sportsdata_proto_rawDescGZIP() []byte {
+	file_rpc_sportsdata_proto_rawDescOnce.Do(func() {
+		file_rpc_sportsdata_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_sportsdata_proto_rawDesc), len(file_rpc_sportsdata_proto_rawDesc)))
+	})
+	return file_rpc_sportsdata_proto_rawDescData
+}
+
+var file_rpc_sportsdata_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_rpc_sportsdata_proto_goTypes = []any{
+	(*Filter)(nil),                      // 0: sportsdata.v1.Filter
+	(*StatRequest)(nil),                 // 1: sportsdata.v1.StatRequest
+	(*ListTeamsRequest)(nil),            // 2: sportsdata.v1.ListTeamsRequest
+	(*ListTeamsResponse)(nil),           // 3: sportsdata.v1.ListTeamsResponse
+	(*ListBattingResponse)(nil),         // 4: sportsdata.v1.ListBattingResponse
+	(*ListPitchingResponse)(nil),        // 5: sportsdata.v1.ListPitchingResponse
+	(*ListBattingSplitsResponse)(nil),   // 6: sportsdata.v1.ListBattingSplitsResponse
+	(*ListPitchingSplitsResponse)(nil),  // 7: sportsdata.v1.ListPitchingSplitsResponse
+	(*ListBaserunningResponse)(nil),     // 8: sportsdata.v1.ListBaserunningResponse
+	(*ListBattingPitchingResponse)(nil), // 9: sportsdata.v1.ListBattingPitchingResponse
+	(*ListSnapshotsRequest)(nil),        // 10: sportsdata.v1.ListSnapshotsRequest
+	(*ListSnapshotsResponse)(nil),       // 11: sportsdata.v1.ListSnapshotsResponse
+	(*PlayerHistoryRequest)(nil),        // 12: sportsdata.v1.PlayerHistoryRequest
+	(*PlayerHistoryResponse)(nil),       // 13: sportsdata.v1.PlayerHistoryResponse
+	(*DiffRequest)(nil),                 // 14: sportsdata.v1.DiffRequest
+	(*DiffBattingResponse)(nil),         // 15: sportsdata.v1.DiffBattingResponse
+	(*DiffPitchingResponse)(nil),        // 16: sportsdata.v1.DiffPitchingResponse
+	(*DiffBaserunningResponse)(nil),     // 17: sportsdata.v1.DiffBaserunningResponse
+	(*LeadersRequest)(nil),              // 18: sportsdata.v1.LeadersRequest
+	(*BattingLeadersResponse)(nil),      // 19: sportsdata.v1.BattingLeadersResponse
+	(*PitchingLeadersResponse)(nil),     // 20: sportsdata.v1.PitchingLeadersResponse
+	(*Team)(nil),                        // 21: sportsdata.v1.Team
+	(*Batter)(nil),                      // 22: sportsdata.v1.Batter
+	(*Pitcher)(nil),                     // 23: sportsdata.v1.Pitcher
+	(*BattingSplit)(nil),                // 24: sportsdata.v1.BattingSplit
+	(*PitchingSplit)(nil),               // 25: sportsdata.v1.PitchingSplit
+	(*Baserunner)(nil),                  // 26: sportsdata.v1.Baserunner
+	(*BattingPitching)(nil),             // 27: sportsdata.v1.BattingPitching
+	(*Snapshot)(nil),                    // 28: sportsdata.v1.Snapshot
+	(*BattingDelta)(nil),                // 29: sportsdata.v1.BattingDelta
+	(*PitchingDelta)(nil),               // 30: sportsdata.v1.PitchingDelta
+	(*BaserunnerDelta)(nil),             // 31: sportsdata.v1.BaserunnerDelta
+	(*BattingLeader)(nil),               // 32: sportsdata.v1.BattingLeader
+	(*PitchingLeader)(nil),              // 33: sportsdata.v1.PitchingLeader
+	(*wrapperspb.StringValue)(nil),      // 34: google.protobuf.StringValue
+	(*wrapperspb.Int64Value)(nil),       // 35: google.protobuf.Int64Value
+	(*wrapperspb.DoubleValue)(nil),      // 36: google.protobuf.DoubleValue
+	(*timestamppb.Timestamp)(nil),       // 37: google.protobuf.Timestamp
+}
+var file_rpc_sportsdata_proto_depIdxs = []int32{
+	0,   // 0: sportsdata.v1.StatRequest.filters:type_name -> sportsdata.v1.Filter
+	21,  // 1: sportsdata.v1.ListTeamsResponse.teams:type_name -> sportsdata.v1.Team
+	22,  // 2: sportsdata.v1.ListBattingResponse.batters:type_name -> sportsdata.v1.Batter
+	23,  // 3: sportsdata.v1.ListPitchingResponse.pitchers:type_name -> sportsdata.v1.Pitcher
+	24,  // 4: sportsdata.v1.ListBattingSplitsResponse.splits:type_name -> sportsdata.v1.BattingSplit
+	25,  // 5: sportsdata.v1.ListPitchingSplitsResponse.splits:type_name -> sportsdata.v1.PitchingSplit
+	26,  // 6: sportsdata.v1.ListBaserunningResponse.baserunners:type_name -> sportsdata.v1.Baserunner
+	27,  // 7: sportsdata.v1.ListBattingPitchingResponse.rows:type_name -> sportsdata.v1.BattingPitching
+	28,  // 8: sportsdata.v1.ListSnapshotsResponse.snapshots:type_name -> sportsdata.v1.Snapshot
+	0,   // 9: sportsdata.v1.PlayerHistoryRequest.filters:type_name -> sportsdata.v1.Filter
+	22,  // 10: sportsdata.v1.PlayerHistoryResponse.batters:type_name -> sportsdata.v1.Batter
+	23,  // 11: sportsdata.v1.PlayerHistoryResponse.pitchers:type_name -> sportsdata.v1.Pitcher
+	26,  // 12: sportsdata.v1.PlayerHistoryResponse.baserunners:type_name -> sportsdata.v1.Baserunner
+	27,  // 13: sportsdata.v1.PlayerHistoryResponse.batting_pitching:type_name -> sportsdata.v1.BattingPitching
+	29,  // 14: sportsdata.v1.DiffBattingResponse.deltas:type_name -> sportsdata.v1.BattingDelta
+	30,  // 15: sportsdata.v1.DiffPitchingResponse.deltas:type_name -> sportsdata.v1.PitchingDelta
+	31,  // 16: sportsdata.v1.DiffBaserunningResponse.deltas:type_name -> sportsdata.v1.BaserunnerDelta
+	0,   // 17: sportsdata.v1.LeadersRequest.filters:type_name -> sportsdata.v1.Filter
+	32,  // 18: sportsdata.v1.BattingLeadersResponse.leaders:type_name -> sportsdata.v1.BattingLeader
+	33,  // 19: sportsdata.v1.PitchingLeadersResponse.leaders:type_name -> sportsdata.v1.PitchingLeader
+	34,  // 20: sportsdata.v1.Batter.pos:type_name -> google.protobuf.StringValue
+	34,  // 21: sportsdata.v1.Batter.name:type_name -> google.protobuf.StringValue
+	35,  // 22: sportsdata.v1.Batter.age:type_name -> google.protobuf.Int64Value
+	35,  // 23: sportsdata.v1.Batter.g:type_name -> google.protobuf.Int64Value
+	35,  // 24: sportsdata.v1.Batter.pa:type_name -> google.protobuf.Int64Value
+	35,  // 25: sportsdata.v1.Batter.ab:type_name -> google.protobuf.Int64Value
+	35,  // 26: sportsdata.v1.Batter.r:type_name -> google.protobuf.Int64Value
+	35,  // 27: sportsdata.v1.Batter.h:type_name -> google.protobuf.Int64Value
+	35,  // 28: sportsdata.v1.Batter.twob:type_name -> google.protobuf.Int64Value
+	35,  // 29: sportsdata.v1.Batter.threeb:type_name -> google.protobuf.Int64Value
+	35,  // 30: sportsdata.v1.Batter.hr:type_name -> google.protobuf.Int64Value
+	35,  // 31: sportsdata.v1.Batter.rbi:type_name -> google.protobuf.Int64Value
+	35,  // 32: sportsdata.v1.Batter.sb:type_name -> google.protobuf.Int64Value
+	35,  // 33: sportsdata.v1.Batter.cs:type_name -> google.protobuf.Int64Value
+	35,  // 34: sportsdata.v1.Batter.bb:type_name -> google.protobuf.Int64Value
+	35,  // 35: sportsdata.v1.Batter.so:type_name -> google.protobuf.Int64Value
+	36,  // 36: sportsdata.v1.Batter.ba:type_name -> google.protobuf.DoubleValue
+	36,  // 37: sportsdata.v1.Batter.obp:type_name -> google.protobuf.DoubleValue
+	36,  // 38: sportsdata.v1.Batter.slg:type_name -> google.protobuf.DoubleValue
+	36,  // 39: sportsdata.v1.Batter.ops:type_name -> google.protobuf.DoubleValue
+	35,  // 40: sportsdata.v1.Batter.opsplus:type_name -> google.protobuf.Int64Value
+	35,  // 41: sportsdata.v1.Batter.tb:type_name -> google.protobuf.Int64Value
+	35,  // 42: sportsdata.v1.Batter.gdp:type_name -> google.protobuf.Int64Value
+	35,  // 43: sportsdata.v1.Batter.hbp:type_name -> google.protobuf.Int64Value
+	35,  // 44: sportsdata.v1.Batter.sh:type_name -> google.protobuf.Int64Value
+	35,  // 45: sportsdata.v1.Batter.sf:type_name -> google.protobuf.Int64Value
+	35,  // 46: sportsdata.v1.Batter.ibb:type_name -> google.protobuf.Int64Value
+	37,  // 47: sportsdata.v1.Batter.createddate:type_name -> google.protobuf.Timestamp
+	34,  // 48: sportsdata.v1.Pitcher.pos:type_name -> google.protobuf.StringValue
+	34,  // 49: sportsdata.v1.Pitcher.name:type_name -> google.protobuf.StringValue
+	35,  // 50: sportsdata.v1.Pitcher.age:type_name -> google.protobuf.Int64Value
+	35,  // 51: sportsdata.v1.Pitcher.w:type_name -> google.protobuf.Int64Value
+	35,  // 52: sportsdata.v1.Pitcher.l:type_name -> google.protobuf.Int64Value
+	36,  // 53: sportsdata.v1.Pitcher.wl:type_name -> google.protobuf.DoubleValue
+	36,  // 54: sportsdata.v1.Pitcher.era:type_name -> google.protobuf.DoubleValue
+	35,  // 55: sportsdata.v1.Pitcher.g:type_name -> google.protobuf.Int64Value
+	35,  // 56: sportsdata.v1.Pitcher.gs:type_name -> google.protobuf.Int64Value
+	35,  // 57: sportsdata.v1.Pitcher.gf:type_name -> google.protobuf.Int64Value
+	35,  // 58: sportsdata.v1.Pitcher.cg:type_name -> google.protobuf.Int64Value
+	35,  // 59: sportsdata.v1.Pitcher.sho:type_name -> google.protobuf.Int64Value
+	35,  // 60: sportsdata.v1.Pitcher.sv:type_name -> google.protobuf.Int64Value
+	36,  // 61: sportsdata.v1.Pitcher.ip:type_name -> google.protobuf.DoubleValue
+	35,  // 62: sportsdata.v1.Pitcher.h:type_name -> google.protobuf.Int64Value
+	35,  // 63: sportsdata.v1.Pitcher.r:type_name -> google.protobuf.Int64Value
+	35,  // 64: sportsdata.v1.Pitcher.er:type_name -> google.protobuf.Int64Value
+	35,  // 65: sportsdata.v1.Pitcher.hr:type_name -> google.protobuf.Int64Value
+	35,  // 66: sportsdata.v1.Pitcher.bb:type_name -> google.protobuf.Int64Value
+	35,  // 67: sportsdata.v1.Pitcher.ibb:type_name -> google.protobuf.Int64Value
+	35,  // 68: sportsdata.v1.Pitcher.so:type_name -> google.protobuf.Int64Value
+	35,  // 69: sportsdata.v1.Pitcher.hbp:type_name -> google.protobuf.Int64Value
+	35,  // 70: sportsdata.v1.Pitcher.bk:type_name -> google.protobuf.Int64Value
+	35,  // 71: sportsdata.v1.Pitcher.wp:type_name -> google.protobuf.Int64Value
+	35,  // 72: sportsdata.v1.Pitcher.bf:type_name -> google.protobuf.Int64Value
+	35,  // 73: sportsdata.v1.Pitcher.eraplus:type_name -> google.protobuf.Int64Value
+	36,  // 74: sportsdata.v1.Pitcher.fip:type_name -> google.protobuf.DoubleValue
+	36,  // 75: sportsdata.v1.Pitcher.whip:type_name -> google.protobuf.DoubleValue
+	36,  // 76: sportsdata.v1.Pitcher.h9:type_name -> google.protobuf.DoubleValue
+	36,  // 77: sportsdata.v1.Pitcher.hr9:type_name -> google.protobuf.DoubleValue
+	36,  // 78: sportsdata.v1.Pitcher.bb9:type_name -> google.protobuf.DoubleValue
+	36,  // 79: sportsdata.v1.Pitcher.so9:type_name -> google.protobuf.DoubleValue
+	36,  // 80: sportsdata.v1.Pitcher.sow:type_name -> google.protobuf.DoubleValue
+	37,  // 81: sportsdata.v1.Pitcher.createddate:type_name -> google.protobuf.Timestamp
+	34,  // 82: sportsdata.v1.BattingSplit.split:type_name -> google.protobuf.StringValue
+	35,  // 83: sportsdata.v1.BattingSplit.g:type_name -> google.protobuf.Int64Value
+	35,  // 84: sportsdata.v1.BattingSplit.gs:type_name -> google.protobuf.Int64Value
+	35,  // 85: sportsdata.v1.BattingSplit.pa:type_name -> google.protobuf.Int64Value
+	35,  // 86: sportsdata.v1.BattingSplit.ab:type_name -> google.protobuf.Int64Value
+	35,  // 87: sportsdata.v1.BattingSplit.r:type_name -> google.protobuf.Int64Value
+	35,  // 88: sportsdata.v1.BattingSplit.h:type_name -> google.protobuf.Int64Value
+	35,  // 89: sportsdata.v1.BattingSplit.twob:type_name -> google.protobuf.Int64Value
+	35,  // 90: sportsdata.v1.BattingSplit.threeb:type_name -> google.protobuf.Int64Value
+	35,  // 91: sportsdata.v1.BattingSplit.hr:type_name -> google.protobuf.Int64Value
+	35,  // 92: sportsdata.v1.BattingSplit.rbi:type_name -> google.protobuf.Int64Value
+	35,  // 93: sportsdata.v1.BattingSplit.sb:type_name -> google.protobuf.Int64Value
+	35,  // 94: sportsdata.v1.BattingSplit.cs:type_name -> google.protobuf.Int64Value
+	35,  // 95: sportsdata.v1.BattingSplit.bb:type_name -> google.protobuf.Int64Value
+	35,  // 96: sportsdata.v1.BattingSplit.so:type_name -> google.protobuf.Int64Value
+	36,  // 97: sportsdata.v1.BattingSplit.ba:type_name -> google.protobuf.DoubleValue
+	36,  // 98: sportsdata.v1.BattingSplit.obp:type_name -> google.protobuf.DoubleValue
+	36,  // 99: sportsdata.v1.BattingSplit.slg:type_name -> google.protobuf.DoubleValue
+	36,  // 100: sportsdata.v1.BattingSplit.ops:type_name -> google.protobuf.DoubleValue
+	35,  // 101: sportsdata.v1.BattingSplit.tb:type_name -> google.protobuf.Int64Value
+	35,  // 102: sportsdata.v1.BattingSplit.gdp:type_name -> google.protobuf.Int64Value
+	35,  // 103: sportsdata.v1.BattingSplit.hbp:type_name -> google.protobuf.Int64Value
+	35,  // 104: sportsdata.v1.BattingSplit.sh:type_name -> google.protobuf.Int64Value
+	35,  // 105: sportsdata.v1.BattingSplit.sf:type_name -> google.protobuf.Int64Value
+	35,  // 106: sportsdata.v1.BattingSplit.ibb:type_name -> google.protobuf.Int64Value
+	35,  // 107: sportsdata.v1.BattingSplit.roe:type_name -> google.protobuf.Int64Value
+	36,  // 108: sportsdata.v1.BattingSplit.babip:type_name -> google.protobuf.DoubleValue
+	35,  // 109: sportsdata.v1.BattingSplit.topsplus:type_name -> google.protobuf.Int64Value
+	35,  // 110: sportsdata.v1.BattingSplit.sopsplus:type_name -> google.protobuf.Int64Value
+	37,  // 111: sportsdata.v1.BattingSplit.createddate:type_name -> google.protobuf.Timestamp
+	34,  // 112: sportsdata.v1.PitchingSplit.split:type_name -> google.protobuf.StringValue
+	35,  // 113: sportsdata.v1.PitchingSplit.g:type_name -> google.protobuf.Int64Value
+	35,  // 114: sportsdata.v1.PitchingSplit.pa:type_name -> google.protobuf.Int64Value
+	35,  // 115: sportsdata.v1.PitchingSplit.ab:type_name -> google.protobuf.Int64Value
+	35,  // 116: sportsdata.v1.PitchingSplit.r:type_name -> google.protobuf.Int64Value
+	35,  // 117: sportsdata.v1.PitchingSplit.h:type_name -> google.protobuf.Int64Value
+	35,  // 118: sportsdata.v1.PitchingSplit.twob:type_name -> google.protobuf.Int64Value
+	35,  // 119: sportsdata.v1.PitchingSplit.threeb:type_name -> google.protobuf.Int64Value
+	35,  // 120: sportsdata.v1.PitchingSplit.hr:type_name -> google.protobuf.Int64Value
+	35,  // 121: sportsdata.v1.PitchingSplit.sb:type_name -> google.protobuf.Int64Value
+	35,  // 122: sportsdata.v1.PitchingSplit.cs:type_name -> google.protobuf.Int64Value
+	35,  // 123: sportsdata.v1.PitchingSplit.bb:type_name -> google.protobuf.Int64Value
+	35,  // 124: sportsdata.v1.PitchingSplit.so:type_name -> google.protobuf.Int64Value
+	36,  // 125: sportsdata.v1.PitchingSplit.sow:type_name -> google.protobuf.DoubleValue
+	36,  // 126: sportsdata.v1.PitchingSplit.ba:type_name -> google.protobuf.DoubleValue
+	36,  // 127: sportsdata.v1.PitchingSplit.obp:type_name -> google.protobuf.DoubleValue
+	36,  // 128: sportsdata.v1.PitchingSplit.slg:type_name -> google.protobuf.DoubleValue
+	36,  // 129: sportsdata.v1.PitchingSplit.ops:type_name -> google.protobuf.DoubleValue
+	35,  // 130: sportsdata.v1.PitchingSplit.tb:type_name -> google.protobuf.Int64Value
+	35,  // 131: sportsdata.v1.PitchingSplit.gdp:type_name -> google.protobuf.Int64Value
+	35,  // 132: sportsdata.v1.PitchingSplit.hbp:type_name -> google.protobuf.Int64Value
+	35,  // 133: sportsdata.v1.PitchingSplit.sh:type_name -> google.protobuf.Int64Value
+	35,  // 134: sportsdata.v1.PitchingSplit.sf:type_name -> google.protobuf.Int64Value
+	35,  // 135: sportsdata.v1.PitchingSplit.ibb:type_name -> google.protobuf.Int64Value
+	35,  // 136: sportsdata.v1.PitchingSplit.roe:type_name -> google.protobuf.Int64Value
+	36,  // 137: sportsdata.v1.PitchingSplit.babip:type_name -> google.protobuf.DoubleValue
+	35,  // 138: sportsdata.v1.PitchingSplit.topsplus:type_name -> google.protobuf.Int64Value
+	35,  // 139: sportsdata.v1.PitchingSplit.sopsplus:type_name -> google.protobuf.Int64Value
+	37,  // 140: sportsdata.v1.PitchingSplit.createddate:type_name -> google.protobuf.Timestamp
+	34,  // 141: sportsdata.v1.Baserunner.name:type_name -> google.protobuf.StringValue
+	35,  // 142: sportsdata.v1.Baserunner.age:type_name -> google.protobuf.Int64Value
+	35,  // 143: sportsdata.v1.Baserunner.pa:type_name -> google.protobuf.Int64Value
+	35,  // 144: sportsdata.v1.Baserunner.roe:type_name -> google.protobuf.Int64Value
+	35,  // 145: sportsdata.v1.Baserunner.xi:type_name -> google.protobuf.Int64Value
+	34,  // 146: sportsdata.v1.Baserunner.rspct:type_name -> google.protobuf.StringValue
+	35,  // 147: sportsdata.v1.Baserunner.sbo:type_name -> google.protobuf.Int64Value
+	35,  // 148: sportsdata.v1.Baserunner.sb:type_name -> google.protobuf.Int64Value
+	35,  // 149: sportsdata.v1.Baserunner.cs:type_name -> google.protobuf.Int64Value
+	34,  // 150: sportsdata.v1.Baserunner.sbpct:type_name -> google.protobuf.StringValue
+	35,  // 151: sportsdata.v1.Baserunner.sb2:type_name -> google.protobuf.Int64Value
+	35,  // 152: sportsdata.v1.Baserunner.cs2:type_name -> google.protobuf.Int64Value
+	35,  // 153: sportsdata.v1.Baserunner.sb3:type_name -> google.protobuf.Int64Value
+	35,  // 154: sportsdata.v1.Baserunner.cs3:type_name -> google.protobuf.Int64Value
+	35,  // 155: sportsdata.v1.Baserunner.sbh:type_name -> google.protobuf.Int64Value
+	35,  // 156: sportsdata.v1.Baserunner.csh:type_name -> google.protobuf.Int64Value
+	35,  // 157: sportsdata.v1.Baserunner.po:type_name -> google.protobuf.Int64Value
+	35,  // 158: sportsdata.v1.Baserunner.pcs:type_name -> google.protobuf.Int64Value
+	35,  // 159: sportsdata.v1.Baserunner.oob:type_name -> google.protobuf.Int64Value
+	35,  // 160: sportsdata.v1.Baserunner.oob1:type_name -> google.protobuf.Int64Value
+	35,  // 161: sportsdata.v1.Baserunner.oob2:type_name -> google.protobuf.Int64Value
+	35,  // 162: sportsdata.v1.Baserunner.oob3:type_name -> google.protobuf.Int64Value
+	35,  // 163: sportsdata.v1.Baserunner.oobhm:type_name -> google.protobuf.Int64Value
+	35,  // 164: sportsdata.v1.Baserunner.bt:type_name -> google.protobuf.Int64Value
+	34,  // 165: sportsdata.v1.Baserunner.xbtpct:type_name -> google.protobuf.StringValue
+	35,  // 166: sportsdata.v1.Baserunner.firsts:type_name -> google.protobuf.Int64Value
+	35,  // 167: sportsdata.v1.Baserunner.firsts2:type_name -> google.protobuf.Int64Value
+	35,  // 168: sportsdata.v1.Baserunner.firsts3:type_name -> google.protobuf.Int64Value
+	35,  // 169: sportsdata.v1.Baserunner.firstd:type_name -> google.protobuf.Int64Value
+	35,  // 170: sportsdata.v1.Baserunner.firstd3:type_name -> google.protobuf.Int64Value
+	35,  // 171: sportsdata.v1.Baserunner.firstdh:type_name -> google.protobuf.Int64Value
+	35,  // 172: sportsdata.v1.Baserunner.seconds:type_name -> google.protobuf.Int64Value
+	35,  // 173: sportsdata.v1.Baserunner.seconds3:type_name -> google.protobuf.Int64Value
+	35,  // 174: sportsdata.v1.Baserunner.secondsh:type_name -> google.protobuf.Int64Value
+	37,  // 175: sportsdata.v1.Baserunner.createddate:type_name -> google.protobuf.Timestamp
+	34,  // 176: sportsdata.v1.BattingPitching.name:type_name -> google.protobuf.StringValue
+	35,  // 177: sportsdata.v1.BattingPitching.age:type_name -> google.protobuf.Int64Value
+	36,  // 178: sportsdata.v1.BattingPitching.ip:type_name -> google.protobuf.DoubleValue
+	35,  // 179: sportsdata.v1.BattingPitching.g:type_name -> google.protobuf.Int64Value
+	35,  // 180: sportsdata.v1.BattingPitching.pa:type_name -> google.protobuf.Int64Value
+	35,  // 181: sportsdata.v1.BattingPitching.ab:type_name -> google.protobuf.Int64Value
+	35,  // 182: sportsdata.v1.BattingPitching.r:type_name -> google.protobuf.Int64Value
+	35,  // 183: sportsdata.v1.BattingPitching.h:type_name -> google.protobuf.Int64Value
+	35,  // 184: sportsdata.v1.BattingPitching.twob:type_name -> google.protobuf.Int64Value
+	35,  // 185: sportsdata.v1.BattingPitching.threeb:type_name -> google.protobuf.Int64Value
+	35,  // 186: sportsdata.v1.BattingPitching.hr:type_name -> google.protobuf.Int64Value
+	35,  // 187: sportsdata.v1.BattingPitching.sb:type_name -> google.protobuf.Int64Value
+	35,  // 188: sportsdata.v1.BattingPitching.cs:type_name -> google.protobuf.Int64Value
+	35,  // 189: sportsdata.v1.BattingPitching.bb:type_name -> google.protobuf.Int64Value
+	35,  // 190: sportsdata.v1.BattingPitching.so:type_name -> google.protobuf.Int64Value
+	36,  // 191: sportsdata.v1.BattingPitching.ba:type_name -> google.protobuf.DoubleValue
+	36,  // 192: sportsdata.v1.BattingPitching.obp:type_name -> google.protobuf.DoubleValue
+	36,  // 193: sportsdata.v1.BattingPitching.slg:type_name -> google.protobuf.DoubleValue
+	36,  // 194: sportsdata.v1.BattingPitching.ops:type_name -> google.protobuf.DoubleValue
+	35,  // 195: sportsdata.v1.BattingPitching.tb:type_name -> google.protobuf.Int64Value
+	35,  // 196: sportsdata.v1.BattingPitching.gdp:type_name -> google.protobuf.Int64Value
+	35,  // 197: sportsdata.v1.BattingPitching.hbp:type_name -> google.protobuf.Int64Value
+	35,  // 198: sportsdata.v1.BattingPitching.sh:type_name -> google.protobuf.Int64Value
+	35,  // 199: sportsdata.v1.BattingPitching.sf:type_name -> google.protobuf.Int64Value
+	35,  // 200: sportsdata.v1.BattingPitching.ibb:type_name -> google.protobuf.Int64Value
+	35,  // 201: sportsdata.v1.BattingPitching.roe:type_name -> google.protobuf.Int64Value
+	36,  // 202: sportsdata.v1.BattingPitching.babip:type_name -> google.protobuf.DoubleValue
+	37,  // 203: sportsdata.v1.BattingPitching.createddate:type_name -> google.protobuf.Timestamp
+	37,  // 204: sportsdata.v1.Snapshot.createddate:type_name -> google.protobuf.Timestamp
+	34,  // 205: sportsdata.v1.BattingDelta.name:type_name -> google.protobuf.StringValue
+	37,  // 206: sportsdata.v1.BattingDelta.fromdate:type_name -> google.protobuf.Timestamp
+	37,  // 207: sportsdata.v1.BattingDelta.todate:type_name -> google.protobuf.Timestamp
+	35,  // 208: sportsdata.v1.BattingDelta.g:type_name -> google.protobuf.Int64Value
+	35,  // 209: sportsdata.v1.BattingDelta.pa:type_name -> google.protobuf.Int64Value
+	35,  // 210: sportsdata.v1.BattingDelta.ab:type_name -> google.protobuf.Int64Value
+	35,  // 211: sportsdata.v1.BattingDelta.r:type_name -> google.protobuf.Int64Value
+	35,  // 212: sportsdata.v1.BattingDelta.h:type_name -> google.protobuf.Int64Value
+	35,  // 213: sportsdata.v1.BattingDelta.twob:type_name -> google.protobuf.Int64Value
+	35,  // 214: sportsdata.v1.BattingDelta.threeb:type_name -> google.protobuf.Int64Value
+	35,  // 215: sportsdata.v1.BattingDelta.hr:type_name -> google.protobuf.Int64Value
+	35,  // 216: sportsdata.v1.BattingDelta.rbi:type_name -> google.protobuf.Int64Value
+	35,  // 217: sportsdata.v1.BattingDelta.sb:type_name -> google.protobuf.Int64Value
+	35,  // 218: sportsdata.v1.BattingDelta.cs:type_name -> google.protobuf.Int64Value
+	35,  // 219: sportsdata.v1.BattingDelta.bb:type_name -> google.protobuf.Int64Value
+	35,  // 220: sportsdata.v1.BattingDelta.so:type_name -> google.protobuf.Int64Value
+	35,  // 221: sportsdata.v1.BattingDelta.tb:type_name -> google.protobuf.Int64Value
+	35,  // 222: sportsdata.v1.BattingDelta.gdp:type_name -> google.protobuf.Int64Value
+	35,  // 223: sportsdata.v1.BattingDelta.hbp:type_name -> google.protobuf.Int64Value
+	35,  // 224: sportsdata.v1.BattingDelta.sh:type_name -> google.protobuf.Int64Value
+	35,  // 225: sportsdata.v1.BattingDelta.sf:type_name -> google.protobuf.Int64Value
+	35,  // 226: sportsdata.v1.BattingDelta.ibb:type_name -> google.protobuf.Int64Value
+	36,  // 227: sportsdata.v1.BattingDelta.ba:type_name -> google.protobuf.DoubleValue
+	36,  // 228: sportsdata.v1.BattingDelta.obp:type_name -> google.protobuf.DoubleValue
+	36,  // 229: sportsdata.v1.BattingDelta.slg:type_name -> google.protobuf.DoubleValue
+	36,  // 230: sportsdata.v1.BattingDelta.ops:type_name -> google.protobuf.DoubleValue
+	34,  // 231: sportsdata.v1.PitchingDelta.name:type_name -> google.protobuf.StringValue
+	37,  // 232: sportsdata.v1.PitchingDelta.fromdate:type_name -> google.protobuf.Timestamp
+	37,  // 233: sportsdata.v1.PitchingDelta.todate:type_name -> google.protobuf.Timestamp
+	35,  // 234: sportsdata.v1.PitchingDelta.w:type_name -> google.protobuf.Int64Value
+	35,  // 235: sportsdata.v1.PitchingDelta.l:type_name -> google.protobuf.Int64Value
+	35,  // 236: sportsdata.v1.PitchingDelta.g:type_name -> google.protobuf.Int64Value
+	35,  // 237: sportsdata.v1.PitchingDelta.gs:type_name -> google.protobuf.Int64Value
+	35,  // 238: sportsdata.v1.PitchingDelta.gf:type_name -> google.protobuf.Int64Value
+	35,  // 239: sportsdata.v1.PitchingDelta.cg:type_name -> google.protobuf.Int64Value
+	35,  // 240: sportsdata.v1.PitchingDelta.sho:type_name -> google.protobuf.Int64Value
+	35,  // 241: sportsdata.v1.PitchingDelta.sv:type_name -> google.protobuf.Int64Value
+	36,  // 242: sportsdata.v1.PitchingDelta.ip:type_name -> google.protobuf.DoubleValue
+	35,  // 243: sportsdata.v1.PitchingDelta.h:type_name -> google.protobuf.Int64Value
+	35,  // 244: sportsdata.v1.PitchingDelta.r:type_name -> google.protobuf.Int64Value
+	35,  // 245: sportsdata.v1.PitchingDelta.er:type_name -> google.protobuf.Int64Value
+	35,  // 246: sportsdata.v1.PitchingDelta.hr:type_name -> google.protobuf.Int64Value
+	35,  // 247: sportsdata.v1.PitchingDelta.bb:type_name -> google.protobuf.Int64Value
+	35,  // 248: sportsdata.v1.PitchingDelta.ibb:type_name -> google.protobuf.Int64Value
+	35,  // 249: sportsdata.v1.PitchingDelta.so:type_name -> google.protobuf.Int64Value
+	35,  // 250: sportsdata.v1.PitchingDelta.hbp:type_name -> google.protobuf.Int64Value
+	35,  // 251: sportsdata.v1.PitchingDelta.bk:type_name -> google.protobuf.Int64Value
+	35,  // 252: sportsdata.v1.PitchingDelta.wp:type_name -> google.protobuf.Int64Value
+	35,  // 253: sportsdata.v1.PitchingDelta.bf:type_name -> google.protobuf.Int64Value
+	36,  // 254: sportsdata.v1.PitchingDelta.wl:type_name -> google.protobuf.DoubleValue
+	36,  // 255: sportsdata.v1.PitchingDelta.era:type_name -> google.protobuf.DoubleValue
+	36,  // 256: sportsdata.v1.PitchingDelta.whip:type_name -> google.protobuf.DoubleValue
+	36,  // 257: sportsdata.v1.PitchingDelta.h9:type_name -> google.protobuf.DoubleValue
+	36,  // 258: sportsdata.v1.PitchingDelta.hr9:type_name -> google.protobuf.DoubleValue
+	36,  // 259: sportsdata.v1.PitchingDelta.bb9:type_name -> google.protobuf.DoubleValue
+	36,  // 260: sportsdata.v1.PitchingDelta.so9:type_name -> google.protobuf.DoubleValue
+	36,  // 261: sportsdata.v1.PitchingDelta.sow:type_name -> google.protobuf.DoubleValue
+	34,  // 262: sportsdata.v1.BaserunnerDelta.name:type_name -> google.protobuf.StringValue
+	37,  // 263: sportsdata.v1.BaserunnerDelta.fromdate:type_name -> google.protobuf.Timestamp
+	37,  // 264: sportsdata.v1.BaserunnerDelta.todate:type_name -> google.protobuf.Timestamp
+	35,  // 265: sportsdata.v1.BaserunnerDelta.pa:type_name -> google.protobuf.Int64Value
+	35,  // 266: sportsdata.v1.BaserunnerDelta.roe:type_name -> google.protobuf.Int64Value
+	35,  // 267: sportsdata.v1.BaserunnerDelta.xi:type_name -> google.protobuf.Int64Value
+	35,  // 268: sportsdata.v1.BaserunnerDelta.sbo:type_name -> google.protobuf.Int64Value
+	35,  // 269: sportsdata.v1.BaserunnerDelta.sb:type_name -> google.protobuf.Int64Value
+	35,  // 270: sportsdata.v1.BaserunnerDelta.cs:type_name -> google.protobuf.Int64Value
+	35,  // 271: sportsdata.v1.BaserunnerDelta.sb2:type_name -> google.protobuf.Int64Value
+	35,  // 272: sportsdata.v1.BaserunnerDelta.cs2:type_name -> google.protobuf.Int64Value
+	35,  // 273: sportsdata.v1.BaserunnerDelta.sb3:type_name -> google.protobuf.Int64Value
+	35,  // 274: sportsdata.v1.BaserunnerDelta.cs3:type_name -> google.protobuf.Int64Value
+	35,  // 275: sportsdata.v1.BaserunnerDelta.sbh:type_name -> google.protobuf.Int64Value
+	35,  // 276: sportsdata.v1.BaserunnerDelta.csh:type_name -> google.protobuf.Int64Value
+	35,  // 277: sportsdata.v1.BaserunnerDelta.po:type_name -> google.protobuf.Int64Value
+	35,  // 278: sportsdata.v1.BaserunnerDelta.pcs:type_name -> google.protobuf.Int64Value
+	35,  // 279: sportsdata.v1.BaserunnerDelta.oob:type_name -> google.protobuf.Int64Value
+	35,  // 280: sportsdata.v1.BaserunnerDelta.oob1:type_name -> google.protobuf.Int64Value
+	35,  // 281: sportsdata.v1.BaserunnerDelta.oob2:type_name -> google.protobuf.Int64Value
+	35,  // 282: sportsdata.v1.BaserunnerDelta.oob3:type_name -> google.protobuf.Int64Value
+	35,  // 283: sportsdata.v1.BaserunnerDelta.oobhm:type_name -> google.protobuf.Int64Value
+	35,  // 284: sportsdata.v1.BaserunnerDelta.bt:type_name -> google.protobuf.Int64Value
+	35,  // 285: sportsdata.v1.BaserunnerDelta.firsts:type_name -> google.protobuf.Int64Value
+	35,  // 286: sportsdata.v1.BaserunnerDelta.firsts2:type_name -> google.protobuf.Int64Value
+	35,  // 287: sportsdata.v1.BaserunnerDelta.firsts3:type_name -> google.protobuf.Int64Value
+	35,  // 288: sportsdata.v1.BaserunnerDelta.firstd:type_name -> google.protobuf.Int64Value
+	35,  // 289: sportsdata.v1.BaserunnerDelta.firstd3:type_name -> google.protobuf.Int64Value
+	35,  // 290: sportsdata.v1.BaserunnerDelta.firstdh:type_name -> google.protobuf.Int64Value
+	35,  // 291: sportsdata.v1.BaserunnerDelta.seconds:type_name -> google.protobuf.Int64Value
+	35,  // 292: sportsdata.v1.BaserunnerDelta.seconds3:type_name -> google.protobuf.Int64Value
+	35,  // 293: sportsdata.v1.BaserunnerDelta.secondsh:type_name -> google.protobuf.Int64Value
+	36,  // 294: sportsdata.v1.BaserunnerDelta.sbpct:type_name -> google.protobuf.DoubleValue
+	22,  // 295: sportsdata.v1.BattingLeader.batter:type_name -> sportsdata.v1.Batter
+	23,  // 296: sportsdata.v1.PitchingLeader.pitcher:type_name -> sportsdata.v1.Pitcher
+	2,   // 297: sportsdata.v1.SportsData.ListTeams:input_type -> sportsdata.v1.ListTeamsRequest
+	1,   // 298: sportsdata.v1.SportsData.ListBatting:input_type -> sportsdata.v1.StatRequest
+	1,   // 299: sportsdata.v1.SportsData.StreamBatting:input_type -> sportsdata.v1.StatRequest
+	1,   // 300: sportsdata.v1.SportsData.ListPitching:input_type -> sportsdata.v1.StatRequest
+	1,   // 301: sportsdata.v1.SportsData.StreamPitching:input_type -> sportsdata.v1.StatRequest
+	1,   // 302: sportsdata.v1.SportsData.ListBattingSplits:input_type -> sportsdata.v1.StatRequest
+	1,   // 303: sportsdata.v1.SportsData.StreamBattingSplits:input_type -> sportsdata.v1.StatRequest
+	1,   // 304: sportsdata.v1.SportsData.ListPitchingSplits:input_type -> sportsdata.v1.StatRequest
+	1,   // 305: sportsdata.v1.SportsData.StreamPitchingSplits:input_type -> sportsdata.v1.StatRequest
+	1,   // 306: sportsdata.v1.SportsData.ListBaserunning:input_type -> sportsdata.v1.StatRequest
+	1,   // 307: sportsdata.v1.SportsData.StreamBaserunning:input_type -> sportsdata.v1.StatRequest
+	1,   // 308: sportsdata.v1.SportsData.ListBattingPitching:input_type -> sportsdata.v1.StatRequest
+	1,   // 309: sportsdata.v1.SportsData.StreamBattingPitching:input_type -> sportsdata.v1.StatRequest
+	1,   // 310: sportsdata.v1.SportsData.ListBattingHomeAway:input_type -> sportsdata.v1.StatRequest
+	1,   // 311: sportsdata.v1.SportsData.StreamBattingHomeAway:input_type -> sportsdata.v1.StatRequest
+	1,   // 312: sportsdata.v1.SportsData.ListPitchingHomeAway:input_type -> sportsdata.v1.StatRequest
+	1,   // 313: sportsdata.v1.SportsData.StreamPitchingHomeAway:input_type -> sportsdata.v1.StatRequest
+	10,  // 314: sportsdata.v1.SportsData.ListSnapshots:input_type -> sportsdata.v1.ListSnapshotsRequest
+	12,  // 315: sportsdata.v1.SportsData.GetPlayerHistory:input_type -> sportsdata.v1.PlayerHistoryRequest
+	14,  // 316: sportsdata.v1.SportsData.DiffBatting:input_type -> sportsdata.v1.DiffRequest
+	14,  // 317: sportsdata.v1.SportsData.DiffPitching:input_type -> sportsdata.v1.DiffRequest
+	14,  // 318: sportsdata.v1.SportsData.DiffBaserunning:input_type -> sportsdata.v1.DiffRequest
+	18,  // 319: sportsdata.v1.SportsData.BattingLeaders:input_type -> sportsdata.v1.LeadersRequest
+	18,  // 320: sportsdata.v1.SportsData.PitchingLeaders:input_type -> sportsdata.v1.LeadersRequest
+	3,   // 321: sportsdata.v1.SportsData.ListTeams:output_type -> sportsdata.v1.ListTeamsResponse
+	4,   // 322: sportsdata.v1.SportsData.ListBatting:output_type -> sportsdata.v1.ListBattingResponse
+	22,  // 323: sportsdata.v1.SportsData.StreamBatting:output_type -> sportsdata.v1.Batter
+	5,   // 324: sportsdata.v1.SportsData.ListPitching:output_type -> sportsdata.v1.ListPitchingResponse
+	23,  // 325: sportsdata.v1.SportsData.StreamPitching:output_type -> sportsdata.v1.Pitcher
+	6,   // 326: sportsdata.v1.SportsData.ListBattingSplits:output_type -> sportsdata.v1.ListBattingSplitsResponse
+	24,  // 327: sportsdata.v1.SportsData.StreamBattingSplits:output_type -> sportsdata.v1.BattingSplit
+	7,   // 328: sportsdata.v1.SportsData.ListPitchingSplits:output_type -> sportsdata.v1.ListPitchingSplitsResponse
+	25,  // 329: sportsdata.v1.SportsData.StreamPitchingSplits:output_type -> sportsdata.v1.PitchingSplit
+	8,   // 330: sportsdata.v1.SportsData.ListBaserunning:output_type -> sportsdata.v1.ListBaserunningResponse
+	26,  // 331: sportsdata.v1.SportsData.StreamBaserunning:output_type -> sportsdata.v1.Baserunner
+	9,   // 332: sportsdata.v1.SportsData.ListBattingPitching:output_type -> sportsdata.v1.ListBattingPitchingResponse
+	27,  // 333: sportsdata.v1.SportsData.StreamBattingPitching:output_type -> sportsdata.v1.BattingPitching
+	6,   // 334: sportsdata.v1.SportsData.ListBattingHomeAway:output_type -> sportsdata.v1.ListBattingSplitsResponse
+	24,  // 335: sportsdata.v1.SportsData.StreamBattingHomeAway:output_type -> sportsdata.v1.BattingSplit
+	7,   // 336: sportsdata.v1.SportsData.ListPitchingHomeAway:output_type -> sportsdata.v1.ListPitchingSplitsResponse
+	25,  // 337: sportsdata.v1.SportsData.StreamPitchingHomeAway:output_type -> sportsdata.v1.PitchingSplit
+	11,  // 338: sportsdata.v1.SportsData.ListSnapshots:output_type -> sportsdata.v1.ListSnapshotsResponse
+	13,  // 339: sportsdata.v1.SportsData.GetPlayerHistory:output_type -> sportsdata.v1.PlayerHistoryResponse
+	15,  // 340: sportsdata.v1.SportsData.DiffBatting:output_type -> sportsdata.v1.DiffBattingResponse
+	16,  // 341: sportsdata.v1.SportsData.DiffPitching:output_type -> sportsdata.v1.DiffPitchingResponse
+	17,  // 342: sportsdata.v1.SportsData.DiffBaserunning:output_type -> sportsdata.v1.DiffBaserunningResponse
+	19,  // 343: sportsdata.v1.SportsData.BattingLeaders:output_type -> sportsdata.v1.BattingLeadersResponse
+	20,  // 344: sportsdata.v1.SportsData.PitchingLeaders:output_type -> sportsdata.v1.PitchingLeadersResponse
+	321, // [321:345] is the sub-list for method output_type
+	297, // [297:321] is the sub-list for method input_type
+	297, // [297:297] is the sub-list for extension type_name
+	297, // [297:297] is the sub-list for extension extendee
+	0,   // [0:297] is the sub-list for field type_name
+}
+
+func init() { file_rpc_sportsdata_proto_init() }
+func file_rpc_sportsdata_proto_init() {
+	if File_rpc_sportsdata_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_sportsdata_proto_rawDesc), len(file_rpc_sportsdata_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   34,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rpc_sportsdata_proto_goTypes,
+		DependencyIndexes: file_rpc_sportsdata_proto_depIdxs,
+		MessageInfos:      file_rpc_sportsdata_proto_msgTypes,
+	}.Build()
+	File_rpc_sportsdata_proto = out.File
+	file_rpc_sportsdata_proto_goTypes = nil
+	file_rpc_sportsdata_proto_depIdxs = nil
+}
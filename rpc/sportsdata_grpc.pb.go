@@ -0,0 +1,1077 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: rpc/sportsdata.proto
+
+// sportsdata mirrors the stat, snapshot, history, diff and leaderboard endpoints of the REST API under /api/v1/mlb; regenerate the Go code with
+// protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative rpc/sportsdata.proto
+
+package rpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SportsData_ListTeams_FullMethodName              = "/sportsdata.v1.SportsData/ListTeams"
+	SportsData_ListBatting_FullMethodName            = "/sportsdata.v1.SportsData/ListBatting"
+	SportsData_StreamBatting_FullMethodName          = "/sportsdata.v1.SportsData/StreamBatting"
+	SportsData_ListPitching_FullMethodName           = "/sportsdata.v1.SportsData/ListPitching"
+	SportsData_StreamPitching_FullMethodName         = "/sportsdata.v1.SportsData/StreamPitching"
+	SportsData_ListBattingSplits_FullMethodName      = "/sportsdata.v1.SportsData/ListBattingSplits"
+	SportsData_StreamBattingSplits_FullMethodName    = "/sportsdata.v1.SportsData/StreamBattingSplits"
+	SportsData_ListPitchingSplits_FullMethodName     = "/sportsdata.v1.SportsData/ListPitchingSplits"
+	SportsData_StreamPitchingSplits_FullMethodName   = "/sportsdata.v1.SportsData/StreamPitchingSplits"
+	SportsData_ListBaserunning_FullMethodName        = "/sportsdata.v1.SportsData/ListBaserunning"
+	SportsData_StreamBaserunning_FullMethodName      = "/sportsdata.v1.SportsData/StreamBaserunning"
+	SportsData_ListBattingPitching_FullMethodName    = "/sportsdata.v1.SportsData/ListBattingPitching"
+	SportsData_StreamBattingPitching_FullMethodName  = "/sportsdata.v1.SportsData/StreamBattingPitching"
+	SportsData_ListBattingHomeAway_FullMethodName    = "/sportsdata.v1.SportsData/ListBattingHomeAway"
+	SportsData_StreamBattingHomeAway_FullMethodName  = "/sportsdata.v1.SportsData/StreamBattingHomeAway"
+	SportsData_ListPitchingHomeAway_FullMethodName   = "/sportsdata.v1.SportsData/ListPitchingHomeAway"
+	SportsData_StreamPitchingHomeAway_FullMethodName = "/sportsdata.v1.SportsData/StreamPitchingHomeAway"
+	SportsData_ListSnapshots_FullMethodName          = "/sportsdata.v1.SportsData/ListSnapshots"
+	SportsData_GetPlayerHistory_FullMethodName       = "/sportsdata.v1.SportsData/GetPlayerHistory"
+	SportsData_DiffBatting_FullMethodName            = "/sportsdata.v1.SportsData/DiffBatting"
+	SportsData_DiffPitching_FullMethodName           = "/sportsdata.v1.SportsData/DiffPitching"
+	SportsData_DiffBaserunning_FullMethodName        = "/sportsdata.v1.SportsData/DiffBaserunning"
+	SportsData_BattingLeaders_FullMethodName         = "/sportsdata.v1.SportsData/BattingLeaders"
+	SportsData_PitchingLeaders_FullMethodName        = "/sportsdata.v1.SportsData/PitchingLeaders"
+)
+
+// SportsDataClient is the client API for SportsData service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SportsData serves the stat tables; every call needs an "authorization: Bearer <token>" metadata entry holding a
+// token from /account/generateToken
+type SportsDataClient interface {
+	// ListTeams returns every team
+	ListTeams(ctx context.Context, in *ListTeamsRequest, opts ...grpc.CallOption) (*ListTeamsResponse, error)
+	// ListBatting returns the latest (or asof) Batter rows of a team, or of every team
+	ListBatting(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (*ListBattingResponse, error)
+	// StreamBatting sends the same rows as ListBatting one message at a time as they are read
+	StreamBatting(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Batter], error)
+	// ListPitching returns the latest (or asof) Pitcher rows of a team, or of every team
+	ListPitching(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (*ListPitchingResponse, error)
+	// StreamPitching sends the same rows as ListPitching one message at a time as they are read
+	StreamPitching(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Pitcher], error)
+	// ListBattingSplits returns the latest (or asof) BattingSplit rows of a team, or of every team
+	ListBattingSplits(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (*ListBattingSplitsResponse, error)
+	// StreamBattingSplits sends the same rows as ListBattingSplits one message at a time as they are read
+	StreamBattingSplits(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BattingSplit], error)
+	// ListPitchingSplits returns the latest (or asof) PitchingSplit rows of a team, or of every team
+	ListPitchingSplits(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (*ListPitchingSplitsResponse, error)
+	// StreamPitchingSplits sends the same rows as ListPitchingSplits one message at a time as they are read
+	StreamPitchingSplits(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PitchingSplit], error)
+	// ListBaserunning returns the latest (or asof) Baserunner rows of a team, or of every team
+	ListBaserunning(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (*ListBaserunningResponse, error)
+	// StreamBaserunning sends the same rows as ListBaserunning one message at a time as they are read
+	StreamBaserunning(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Baserunner], error)
+	// ListBattingPitching returns the latest (or asof) BattingPitching rows of a team, or of every team
+	ListBattingPitching(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (*ListBattingPitchingResponse, error)
+	// StreamBattingPitching sends the same rows as ListBattingPitching one message at a time as they are read
+	StreamBattingPitching(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BattingPitching], error)
+	// ListBattingHomeAway returns the latest (or asof) batting_home_away rows of a team, or of every team
+	ListBattingHomeAway(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (*ListBattingSplitsResponse, error)
+	// StreamBattingHomeAway sends the same rows as ListBattingHomeAway one message at a time as they are read
+	StreamBattingHomeAway(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BattingSplit], error)
+	// ListPitchingHomeAway returns the latest (or asof) pitching_home_away rows of a team, or of every team
+	ListPitchingHomeAway(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (*ListPitchingSplitsResponse, error)
+	// StreamPitchingHomeAway sends the same rows as ListPitchingHomeAway one message at a time as they are read
+	StreamPitchingHomeAway(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PitchingSplit], error)
+	// ListSnapshots lists the createddate snapshots held per table and team
+	ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error)
+	// GetPlayerHistory returns every snapshot row of a single player ordered by createddate
+	GetPlayerHistory(ctx context.Context, in *PlayerHistoryRequest, opts ...grpc.CallOption) (*PlayerHistoryResponse, error)
+	// DiffBatting returns per-player batting deltas of a team between two snapshots
+	DiffBatting(ctx context.Context, in *DiffRequest, opts ...grpc.CallOption) (*DiffBattingResponse, error)
+	// DiffPitching returns per-player pitching deltas of a team between two snapshots
+	DiffPitching(ctx context.Context, in *DiffRequest, opts ...grpc.CallOption) (*DiffPitchingResponse, error)
+	// DiffBaserunning returns per-player baserunning deltas of a team between two snapshots
+	DiffBaserunning(ctx context.Context, in *DiffRequest, opts ...grpc.CallOption) (*DiffBaserunningResponse, error)
+	// BattingLeaders ranks the latest (or asof) batting rows of every team
+	BattingLeaders(ctx context.Context, in *LeadersRequest, opts ...grpc.CallOption) (*BattingLeadersResponse, error)
+	// PitchingLeaders ranks the latest (or asof) pitching rows of every team
+	PitchingLeaders(ctx context.Context, in *LeadersRequest, opts ...grpc.CallOption) (*PitchingLeadersResponse, error)
+}
+
+type sportsDataClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSportsDataClient(cc grpc.ClientConnInterface) SportsDataClient {
+	return &sportsDataClient{cc}
+}
+
+func (c *sportsDataClient) ListTeams(ctx context.Context, in *ListTeamsRequest, opts ...grpc.CallOption) (*ListTeamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTeamsResponse)
+	err := c.cc.Invoke(ctx, SportsData_ListTeams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsDataClient) ListBatting(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (*ListBattingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBattingResponse)
+	err := c.cc.Invoke(ctx, SportsData_ListBatting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsDataClient) StreamBatting(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Batter], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SportsData_ServiceDesc.Streams[0], SportsData_StreamBatting_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StatRequest, Batter]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SportsData_StreamBattingClient = grpc.ServerStreamingClient[Batter]
+
+func (c *sportsDataClient) ListPitching(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (*ListPitchingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPitchingResponse)
+	err := c.cc.Invoke(ctx, SportsData_ListPitching_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsDataClient) StreamPitching(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Pitcher], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SportsData_ServiceDesc.Streams[1], SportsData_StreamPitching_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StatRequest, Pitcher]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SportsData_StreamPitchingClient = grpc.ServerStreamingClient[Pitcher]
+
+func (c *sportsDataClient) ListBattingSplits(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (*ListBattingSplitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBattingSplitsResponse)
+	err := c.cc.Invoke(ctx, SportsData_ListBattingSplits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsDataClient) StreamBattingSplits(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BattingSplit], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SportsData_ServiceDesc.Streams[2], SportsData_StreamBattingSplits_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StatRequest, BattingSplit]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SportsData_StreamBattingSplitsClient = grpc.ServerStreamingClient[BattingSplit]
+
+func (c *sportsDataClient) ListPitchingSplits(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (*ListPitchingSplitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPitchingSplitsResponse)
+	err := c.cc.Invoke(ctx, SportsData_ListPitchingSplits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsDataClient) StreamPitchingSplits(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PitchingSplit], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SportsData_ServiceDesc.Streams[3], SportsData_StreamPitchingSplits_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StatRequest, PitchingSplit]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SportsData_StreamPitchingSplitsClient = grpc.ServerStreamingClient[PitchingSplit]
+
+func (c *sportsDataClient) ListBaserunning(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (*ListBaserunningResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBaserunningResponse)
+	err := c.cc.Invoke(ctx, SportsData_ListBaserunning_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsDataClient) StreamBaserunning(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Baserunner], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SportsData_ServiceDesc.Streams[4], SportsData_StreamBaserunning_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StatRequest, Baserunner]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SportsData_StreamBaserunningClient = grpc.ServerStreamingClient[Baserunner]
+
+func (c *sportsDataClient) ListBattingPitching(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (*ListBattingPitchingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBattingPitchingResponse)
+	err := c.cc.Invoke(ctx, SportsData_ListBattingPitching_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsDataClient) StreamBattingPitching(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BattingPitching], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SportsData_ServiceDesc.Streams[5], SportsData_StreamBattingPitching_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StatRequest, BattingPitching]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SportsData_StreamBattingPitchingClient = grpc.ServerStreamingClient[BattingPitching]
+
+func (c *sportsDataClient) ListBattingHomeAway(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (*ListBattingSplitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBattingSplitsResponse)
+	err := c.cc.Invoke(ctx, SportsData_ListBattingHomeAway_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsDataClient) StreamBattingHomeAway(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BattingSplit], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SportsData_ServiceDesc.Streams[6], SportsData_StreamBattingHomeAway_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StatRequest, BattingSplit]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SportsData_StreamBattingHomeAwayClient = grpc.ServerStreamingClient[BattingSplit]
+
+func (c *sportsDataClient) ListPitchingHomeAway(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (*ListPitchingSplitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPitchingSplitsResponse)
+	err := c.cc.Invoke(ctx, SportsData_ListPitchingHomeAway_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsDataClient) StreamPitchingHomeAway(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PitchingSplit], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SportsData_ServiceDesc.Streams[7], SportsData_StreamPitchingHomeAway_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StatRequest, PitchingSplit]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SportsData_StreamPitchingHomeAwayClient = grpc.ServerStreamingClient[PitchingSplit]
+
+func (c *sportsDataClient) ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSnapshotsResponse)
+	err := c.cc.Invoke(ctx, SportsData_ListSnapshots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsDataClient) GetPlayerHistory(ctx context.Context, in *PlayerHistoryRequest, opts ...grpc.CallOption) (*PlayerHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlayerHistoryResponse)
+	err := c.cc.Invoke(ctx, SportsData_GetPlayerHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsDataClient) DiffBatting(ctx context.Context, in *DiffRequest, opts ...grpc.CallOption) (*DiffBattingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffBattingResponse)
+	err := c.cc.Invoke(ctx, SportsData_DiffBatting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsDataClient) DiffPitching(ctx context.Context, in *DiffRequest, opts ...grpc.CallOption) (*DiffPitchingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffPitchingResponse)
+	err := c.cc.Invoke(ctx, SportsData_DiffPitching_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsDataClient) DiffBaserunning(ctx context.Context, in *DiffRequest, opts ...grpc.CallOption) (*DiffBaserunningResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffBaserunningResponse)
+	err := c.cc.Invoke(ctx, SportsData_DiffBaserunning_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsDataClient) BattingLeaders(ctx context.Context, in *LeadersRequest, opts ...grpc.CallOption) (*BattingLeadersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BattingLeadersResponse)
+	err := c.cc.Invoke(ctx, SportsData_BattingLeaders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsDataClient) PitchingLeaders(ctx context.Context, in *LeadersRequest, opts ...grpc.CallOption) (*PitchingLeadersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PitchingLeadersResponse)
+	err := c.cc.Invoke(ctx, SportsData_PitchingLeaders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SportsDataServer is the server API for SportsData service.
+// All implementations must embed UnimplementedSportsDataServer
+// for forward compatibility.
+//
+// SportsData serves the stat tables; every call needs an "authorization: Bearer <token>" metadata entry holding a
+// token from /account/generateToken
+type SportsDataServer interface {
+	// ListTeams returns every team
+	ListTeams(context.Context, *ListTeamsRequest) (*ListTeamsResponse, error)
+	// ListBatting returns the latest (or asof) Batter rows of a team, or of every team
+	ListBatting(context.Context, *StatRequest) (*ListBattingResponse, error)
+	// StreamBatting sends the same rows as ListBatting one message at a time as they are read
+	StreamBatting(*StatRequest, grpc.ServerStreamingServer[Batter]) error
+	// ListPitching returns the latest (or asof) Pitcher rows of a team, or of every team
+	ListPitching(context.Context, *StatRequest) (*ListPitchingResponse, error)
+	// StreamPitching sends the same rows as ListPitching one message at a time as they are read
+	StreamPitching(*StatRequest, grpc.ServerStreamingServer[Pitcher]) error
+	// ListBattingSplits returns the latest (or asof) BattingSplit rows of a team, or of every team
+	ListBattingSplits(context.Context, *StatRequest) (*ListBattingSplitsResponse, error)
+	// StreamBattingSplits sends the same rows as ListBattingSplits one message at a time as they are read
+	StreamBattingSplits(*StatRequest, grpc.ServerStreamingServer[BattingSplit]) error
+	// ListPitchingSplits returns the latest (or asof) PitchingSplit rows of a team, or of every team
+	ListPitchingSplits(context.Context, *StatRequest) (*ListPitchingSplitsResponse, error)
+	// StreamPitchingSplits sends the same rows as ListPitchingSplits one message at a time as they are read
+	StreamPitchingSplits(*StatRequest, grpc.ServerStreamingServer[PitchingSplit]) error
+	// ListBaserunning returns the latest (or asof) Baserunner rows of a team, or of every team
+	ListBaserunning(context.Context, *StatRequest) (*ListBaserunningResponse, error)
+	// StreamBaserunning sends the same rows as ListBaserunning one message at a time as they are read
+	StreamBaserunning(*StatRequest, grpc.ServerStreamingServer[Baserunner]) error
+	// ListBattingPitching returns the latest (or asof) BattingPitching rows of a team, or of every team
+	ListBattingPitching(context.Context, *StatRequest) (*ListBattingPitchingResponse, error)
+	// StreamBattingPitching sends the same rows as ListBattingPitching one message at a time as they are read
+	StreamBattingPitching(*StatRequest, grpc.ServerStreamingServer[BattingPitching]) error
+	// ListBattingHomeAway returns the latest (or asof) batting_home_away rows of a team, or of every team
+	ListBattingHomeAway(context.Context, *StatRequest) (*ListBattingSplitsResponse, error)
+	// StreamBattingHomeAway sends the same rows as ListBattingHomeAway one message at a time as they are read
+	StreamBattingHomeAway(*StatRequest, grpc.ServerStreamingServer[BattingSplit]) error
+	// ListPitchingHomeAway returns the latest (or asof) pitching_home_away rows of a team, or of every team
+	ListPitchingHomeAway(context.Context, *StatRequest) (*ListPitchingSplitsResponse, error)
+	// StreamPitchingHomeAway sends the same rows as ListPitchingHomeAway one message at a time as they are read
+	StreamPitchingHomeAway(*StatRequest, grpc.ServerStreamingServer[PitchingSplit]) error
+	// ListSnapshots lists the createddate snapshots held per table and team
+	ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error)
+	// GetPlayerHistory returns every snapshot row of a single player ordered by createddate
+	GetPlayerHistory(context.Context, *PlayerHistoryRequest) (*PlayerHistoryResponse, error)
+	// DiffBatting returns per-player batting deltas of a team between two snapshots
+	DiffBatting(context.Context, *DiffRequest) (*DiffBattingResponse, error)
+	// DiffPitching returns per-player pitching deltas of a team between two snapshots
+	DiffPitching(context.Context, *DiffRequest) (*DiffPitchingResponse, error)
+	// DiffBaserunning returns per-player baserunning deltas of a team between two snapshots
+	DiffBaserunning(context.Context, *DiffRequest) (*DiffBaserunningResponse, error)
+	// BattingLeaders ranks the latest (or asof) batting rows of every team
+	BattingLeaders(context.Context, *LeadersRequest) (*BattingLeadersResponse, error)
+	// PitchingLeaders ranks the latest (or asof) pitching rows of every team
+	PitchingLeaders(context.Context, *LeadersRequest) (*PitchingLeadersResponse, error)
+	mustEmbedUnimplementedSportsDataServer()
+}
+
+// UnimplementedSportsDataServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSportsDataServer struct{}
+
+func (UnimplementedSportsDataServer) ListTeams(context.Context, *ListTeamsRequest) (*ListTeamsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTeams not implemented")
+}
+func (UnimplementedSportsDataServer) ListBatting(context.Context, *StatRequest) (*ListBattingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBatting not implemented")
+}
+func (UnimplementedSportsDataServer) StreamBatting(*StatRequest, grpc.ServerStreamingServer[Batter]) error {
+	return status.Error(codes.Unimplemented, "method StreamBatting not implemented")
+}
+func (UnimplementedSportsDataServer) ListPitching(context.Context, *StatRequest) (*ListPitchingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPitching not implemented")
+}
+func (UnimplementedSportsDataServer) StreamPitching(*StatRequest, grpc.ServerStreamingServer[Pitcher]) error {
+	return status.Error(codes.Unimplemented, "method StreamPitching not implemented")
+}
+func (UnimplementedSportsDataServer) ListBattingSplits(context.Context, *StatRequest) (*ListBattingSplitsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBattingSplits not implemented")
+}
+func (UnimplementedSportsDataServer) StreamBattingSplits(*StatRequest, grpc.ServerStreamingServer[BattingSplit]) error {
+	return status.Error(codes.Unimplemented, "method StreamBattingSplits not implemented")
+}
+func (UnimplementedSportsDataServer) ListPitchingSplits(context.Context, *StatRequest) (*ListPitchingSplitsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPitchingSplits not implemented")
+}
+func (UnimplementedSportsDataServer) StreamPitchingSplits(*StatRequest, grpc.ServerStreamingServer[PitchingSplit]) error {
+	return status.Error(codes.Unimplemented, "method StreamPitchingSplits not implemented")
+}
+func (UnimplementedSportsDataServer) ListBaserunning(context.Context, *StatRequest) (*ListBaserunningResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBaserunning not implemented")
+}
+func (UnimplementedSportsDataServer) StreamBaserunning(*StatRequest, grpc.ServerStreamingServer[Baserunner]) error {
+	return status.Error(codes.Unimplemented, "method StreamBaserunning not implemented")
+}
+func (UnimplementedSportsDataServer) ListBattingPitching(context.Context, *StatRequest) (*ListBattingPitchingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBattingPitching not implemented")
+}
+func (UnimplementedSportsDataServer) StreamBattingPitching(*StatRequest, grpc.ServerStreamingServer[BattingPitching]) error {
+	return status.Error(codes.Unimplemented, "method StreamBattingPitching not implemented")
+}
+func (UnimplementedSportsDataServer) ListBattingHomeAway(context.Context, *StatRequest) (*ListBattingSplitsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBattingHomeAway not implemented")
+}
+func (UnimplementedSportsDataServer) StreamBattingHomeAway(*StatRequest, grpc.ServerStreamingServer[BattingSplit]) error {
+	return status.Error(codes.Unimplemented, "method StreamBattingHomeAway not implemented")
+}
+func (UnimplementedSportsDataServer) ListPitchingHomeAway(context.Context, *StatRequest) (*ListPitchingSplitsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPitchingHomeAway not implemented")
+}
+func (UnimplementedSportsDataServer) StreamPitchingHomeAway(*StatRequest, grpc.ServerStreamingServer[PitchingSplit]) error {
+	return status.Error(codes.Unimplemented, "method StreamPitchingHomeAway not implemented")
+}
+func (UnimplementedSportsDataServer) ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSnapshots not implemented")
+}
+func (UnimplementedSportsDataServer) GetPlayerHistory(context.Context, *PlayerHistoryRequest) (*PlayerHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPlayerHistory not implemented")
+}
+func (UnimplementedSportsDataServer) DiffBatting(context.Context, *DiffRequest) (*DiffBattingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiffBatting not implemented")
+}
+func (UnimplementedSportsDataServer) DiffPitching(context.Context, *DiffRequest) (*DiffPitchingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiffPitching not implemented")
+}
+func (UnimplementedSportsDataServer) DiffBaserunning(context.Context, *DiffRequest) (*DiffBaserunningResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiffBaserunning not implemented")
+}
+func (UnimplementedSportsDataServer) BattingLeaders(context.Context, *LeadersRequest) (*BattingLeadersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BattingLeaders not implemented")
+}
+func (UnimplementedSportsDataServer) PitchingLeaders(context.Context, *LeadersRequest) (*PitchingLeadersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PitchingLeaders not implemented")
+}
+func (UnimplementedSportsDataServer) mustEmbedUnimplementedSportsDataServer() {}
+func (UnimplementedSportsDataServer) testEmbeddedByValue()                    {}
+
+// UnsafeSportsDataServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SportsDataServer will
+// result in compilation errors.
+type UnsafeSportsDataServer interface {
+	mustEmbedUnimplementedSportsDataServer()
+}
+
+func RegisterSportsDataServer(s grpc.ServiceRegistrar, srv SportsDataServer) {
+	// If the following call panics, it indicates UnimplementedSportsDataServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SportsData_ServiceDesc, srv)
+}
+
+func _SportsData_ListTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTeamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsDataServer).ListTeams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SportsData_ListTeams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsDataServer).ListTeams(ctx, req.(*ListTeamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SportsData_ListBatting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsDataServer).ListBatting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SportsData_ListBatting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsDataServer).ListBatting(ctx, req.(*StatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SportsData_StreamBatting_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StatRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SportsDataServer).StreamBatting(m, &grpc.GenericServerStream[StatRequest, Batter]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SportsData_StreamBattingServer = grpc.ServerStreamingServer[Batter]
+
+func _SportsData_ListPitching_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsDataServer).ListPitching(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SportsData_ListPitching_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsDataServer).ListPitching(ctx, req.(*StatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SportsData_StreamPitching_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StatRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SportsDataServer).StreamPitching(m, &grpc.GenericServerStream[StatRequest, Pitcher]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SportsData_StreamPitchingServer = grpc.ServerStreamingServer[Pitcher]
+
+func _SportsData_ListBattingSplits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsDataServer).ListBattingSplits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SportsData_ListBattingSplits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsDataServer).ListBattingSplits(ctx, req.(*StatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SportsData_StreamBattingSplits_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StatRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SportsDataServer).StreamBattingSplits(m, &grpc.GenericServerStream[StatRequest, BattingSplit]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SportsData_StreamBattingSplitsServer = grpc.ServerStreamingServer[BattingSplit]
+
+func _SportsData_ListPitchingSplits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsDataServer).ListPitchingSplits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SportsData_ListPitchingSplits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsDataServer).ListPitchingSplits(ctx, req.(*StatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SportsData_StreamPitchingSplits_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StatRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SportsDataServer).StreamPitchingSplits(m, &grpc.GenericServerStream[StatRequest, PitchingSplit]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SportsData_StreamPitchingSplitsServer = grpc.ServerStreamingServer[PitchingSplit]
+
+func _SportsData_ListBaserunning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsDataServer).ListBaserunning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SportsData_ListBaserunning_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsDataServer).ListBaserunning(ctx, req.(*StatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SportsData_StreamBaserunning_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StatRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SportsDataServer).StreamBaserunning(m, &grpc.GenericServerStream[StatRequest, Baserunner]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SportsData_StreamBaserunningServer = grpc.ServerStreamingServer[Baserunner]
+
+func _SportsData_ListBattingPitching_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsDataServer).ListBattingPitching(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SportsData_ListBattingPitching_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsDataServer).ListBattingPitching(ctx, req.(*StatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SportsData_StreamBattingPitching_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StatRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SportsDataServer).StreamBattingPitching(m, &grpc.GenericServerStream[StatRequest, BattingPitching]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SportsData_StreamBattingPitchingServer = grpc.ServerStreamingServer[BattingPitching]
+
+func _SportsData_ListBattingHomeAway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsDataServer).ListBattingHomeAway(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SportsData_ListBattingHomeAway_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsDataServer).ListBattingHomeAway(ctx, req.(*StatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SportsData_StreamBattingHomeAway_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StatRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SportsDataServer).StreamBattingHomeAway(m, &grpc.GenericServerStream[StatRequest, BattingSplit]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SportsData_StreamBattingHomeAwayServer = grpc.ServerStreamingServer[BattingSplit]
+
+func _SportsData_ListPitchingHomeAway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsDataServer).ListPitchingHomeAway(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SportsData_ListPitchingHomeAway_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsDataServer).ListPitchingHomeAway(ctx, req.(*StatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SportsData_StreamPitchingHomeAway_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StatRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SportsDataServer).StreamPitchingHomeAway(m, &grpc.GenericServerStream[StatRequest, PitchingSplit]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SportsData_StreamPitchingHomeAwayServer = grpc.ServerStreamingServer[PitchingSplit]
+
+func _SportsData_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsDataServer).ListSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SportsData_ListSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsDataServer).ListSnapshots(ctx, req.(*ListSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SportsData_GetPlayerHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlayerHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsDataServer).GetPlayerHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SportsData_GetPlayerHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsDataServer).GetPlayerHistory(ctx, req.(*PlayerHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SportsData_DiffBatting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsDataServer).DiffBatting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SportsData_DiffBatting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsDataServer).DiffBatting(ctx, req.(*DiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SportsData_DiffPitching_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsDataServer).DiffPitching(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SportsData_DiffPitching_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsDataServer).DiffPitching(ctx, req.(*DiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SportsData_DiffBaserunning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsDataServer).DiffBaserunning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SportsData_DiffBaserunning_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsDataServer).DiffBaserunning(ctx, req.(*DiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SportsData_BattingLeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeadersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsDataServer).BattingLeaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SportsData_BattingLeaders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsDataServer).BattingLeaders(ctx, req.(*LeadersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SportsData_PitchingLeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeadersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsDataServer).PitchingLeaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SportsData_PitchingLeaders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsDataServer).PitchingLeaders(ctx, req.(*LeadersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SportsData_ServiceDesc is the grpc.ServiceDesc for SportsData service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SportsData_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sportsdata.v1.SportsData",
+	HandlerType: (*SportsDataServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListTeams",
+			Handler:    _SportsData_ListTeams_Handler,
+		},
+		{
+			MethodName: "ListBatting",
+			Handler:    _SportsData_ListBatting_Handler,
+		},
+		{
+			MethodName: "ListPitching",
+			Handler:    _SportsData_ListPitching_Handler,
+		},
+		{
+			MethodName: "ListBattingSplits",
+			Handler:    _SportsData_ListBattingSplits_Handler,
+		},
+		{
+			MethodName: "ListPitchingSplits",
+			Handler:    _SportsData_ListPitchingSplits_Handler,
+		},
+		{
+			MethodName: "ListBaserunning",
+			Handler:    _SportsData_ListBaserunning_Handler,
+		},
+		{
+			MethodName: "ListBattingPitching",
+			Handler:    _SportsData_ListBattingPitching_Handler,
+		},
+		{
+			MethodName: "ListBattingHomeAway",
+			Handler:    _SportsData_ListBattingHomeAway_Handler,
+		},
+		{
+			MethodName: "ListPitchingHomeAway",
+			Handler:    _SportsData_ListPitchingHomeAway_Handler,
+		},
+		{
+			MethodName: "ListSnapshots",
+			Handler:    _SportsData_ListSnapshots_Handler,
+		},
+		{
+			MethodName: "GetPlayerHistory",
+			Handler:    _SportsData_GetPlayerHistory_Handler,
+		},
+		{
+			MethodName: "DiffBatting",
+			Handler:    _SportsData_DiffBatting_Handler,
+		},
+		{
+			MethodName: "DiffPitching",
+			Handler:    _SportsData_DiffPitching_Handler,
+		},
+		{
+			MethodName: "DiffBaserunning",
+			Handler:    _SportsData_DiffBaserunning_Handler,
+		},
+		{
+			MethodName: "BattingLeaders",
+			Handler:    _SportsData_BattingLeaders_Handler,
+		},
+		{
+			MethodName: "PitchingLeaders",
+			Handler:    _SportsData_PitchingLeaders_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamBatting",
+			Handler:       _SportsData_StreamBatting_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamPitching",
+			Handler:       _SportsData_StreamPitching_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamBattingSplits",
+			Handler:       _SportsData_StreamBattingSplits_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamPitchingSplits",
+			Handler:       _SportsData_StreamPitchingSplits_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamBaserunning",
+			Handler:       _SportsData_StreamBaserunning_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamBattingPitching",
+			Handler:       _SportsData_StreamBattingPitching_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamBattingHomeAway",
+			Handler:       _SportsData_StreamBattingHomeAway_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamPitchingHomeAway",
+			Handler:       _SportsData_StreamPitchingHomeAway_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "rpc/sportsdata.proto",
+}